@@ -0,0 +1,10 @@
+# Note that some terraform code is not included in the example for brevity
+
+data "netbox_cable_trace" "uplink" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.uplink.id
+}
+
+output "uplink_peer" {
+  value = one(data.netbox_cable_trace.uplink.far_end[*].display)
+}
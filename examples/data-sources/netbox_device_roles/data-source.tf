@@ -0,0 +1,11 @@
+data "netbox_device_role" "network" {
+  name = "Network"
+}
+
+# All roles below the network role, e.g. core and access switches
+data "netbox_device_roles" "network" {
+  filter {
+    name  = "parent_id"
+    value = data.netbox_device_role.network.id
+  }
+}
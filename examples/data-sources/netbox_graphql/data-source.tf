@@ -0,0 +1,21 @@
+data "netbox_graphql" "site_interfaces" {
+  query = <<-EOT
+    query ($site: [String!]) {
+      device_list(filters: {site: $site}) {
+        name
+        interfaces {
+          name
+          ip_addresses { address }
+        }
+      }
+    }
+  EOT
+
+  variables = jsonencode({
+    site = ["dc1"]
+  })
+}
+
+output "devices" {
+  value = jsondecode(data.netbox_graphql.site_interfaces.result).device_list
+}
@@ -0,0 +1,13 @@
+data "netbox_platforms" "cisco" {
+  filter {
+    name  = "manufacturer"
+    value = "cisco"
+  }
+}
+
+# The config templates of all Cisco platforms, by platform slug
+locals {
+  config_template_ids = {
+    for platform in data.netbox_platforms.cisco.platforms : platform.slug => platform.config_template_id
+  }
+}
@@ -0,0 +1,10 @@
+data "netbox_query" "l2vpns" {
+  path = "vpn/l2vpns"
+  filters = {
+    type = "vxlan"
+  }
+}
+
+output "l2vpn_identifiers" {
+  value = [for l2vpn in jsondecode(data.netbox_query.l2vpns.results) : l2vpn.identifier]
+}
@@ -0,0 +1,15 @@
+# Note that some terraform code is not included in the example for brevity
+
+data "netbox_rack_elevation" "r1" {
+  rack_id = netbox_rack.r1.id
+}
+
+locals {
+  units = data.netbox_rack_elevation.r1.unit
+
+  # the lowest position with two free units above each other
+  first_free_2u = min([
+    for i, unit in local.units : unit.position
+    if i > 0 && !unit.occupied && !local.units[i - 1].occupied
+  ]...)
+}
@@ -0,0 +1,7 @@
+data "netbox_virtual_chassis" "stack" {
+  name = "sw-stack01"
+}
+
+output "stack_members" {
+  value = data.netbox_virtual_chassis.stack.members[*].name
+}
@@ -0,0 +1,6 @@
+ephemeral "netbox_api_token" "ci" {
+  username    = "ci-pipeline"
+  password    = var.ci_password
+  description = "Short-lived token for the current Terraform run"
+  expires     = timeadd(plantimestamp(), "1h")
+}
@@ -0,0 +1,7 @@
+data "netbox_prefix" "test" {
+  prefix = "10.0.0.0/24"
+}
+
+ephemeral "netbox_available_ip_address" "next" {
+  prefix_id = data.netbox_prefix.test.id
+}
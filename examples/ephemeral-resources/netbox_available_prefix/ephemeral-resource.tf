@@ -0,0 +1,8 @@
+data "netbox_prefix" "parent" {
+  prefix = "10.0.0.0/16"
+}
+
+ephemeral "netbox_available_prefix" "next" {
+  parent_prefix_id = data.netbox_prefix.parent.id
+  prefix_length    = 24
+}
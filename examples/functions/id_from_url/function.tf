@@ -0,0 +1,4 @@
+import {
+  to = netbox_device.switch
+  id = provider::netbox::id_from_url("https://netbox.example.com/dcim/devices/42/")
+}
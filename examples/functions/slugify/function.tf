@@ -0,0 +1,4 @@
+resource "netbox_site" "example" {
+  name = "My Site #1"
+  slug = provider::netbox::slugify("My Site #1") # "my-site-1"
+}
@@ -0,0 +1,7 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_console_port_template" "console" {
+  name           = "Console"
+  type           = "rj-45"
+  device_type_id = netbox_device_type.switch.id
+}
@@ -0,0 +1,7 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_console_server_port_template" "port1" {
+  name           = "Port 1"
+  type           = "rj-45"
+  device_type_id = netbox_device_type.terminal_server.id
+}
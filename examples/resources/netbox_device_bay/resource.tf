@@ -0,0 +1,21 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_device" "chassis" {
+  name           = "chassis"
+  device_type_id = netbox_device_type.chassis.id
+  role_id        = netbox_device_role.server.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device" "blade" {
+  name           = "blade-1"
+  device_type_id = netbox_device_type.blade.id
+  role_id        = netbox_device_role.server.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_bay" "slot1" {
+  device_id           = netbox_device.chassis.id
+  name                = "Slot 1"
+  installed_device_id = netbox_device.blade.id
+}
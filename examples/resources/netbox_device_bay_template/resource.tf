@@ -0,0 +1,7 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_device_bay_template" "slot1" {
+  name           = "Slot 1"
+  label          = "Blade slot 1"
+  device_type_id = netbox_device_type.chassis.id
+}
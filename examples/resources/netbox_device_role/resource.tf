@@ -1,4 +1,4 @@
 resource "netbox_device_role" "core_sw" {
-  color_hex = "FF00FF"
+  color_hex = "ff00ff"
   name      = "core-sw"
 }
@@ -0,0 +1,17 @@
+resource "netbox_manufacturer" "cisco" {
+  name = "Cisco"
+}
+
+# The document of the device type library, e.g. checked out next to the configuration
+resource "netbox_device_type_import" "c9300_48p" {
+  yaml = file("${path.module}/devicetype-library/device-types/Cisco/C9300-48P.yaml")
+
+  depends_on = [netbox_manufacturer.cisco]
+}
+
+resource "netbox_device" "switch" {
+  name           = "switch01"
+  device_type_id = netbox_device_type_import.c9300_48p.id
+  role_id        = 1
+  site_id        = 1
+}
@@ -0,0 +1,17 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_rear_port_template" "trunk" {
+  name           = "Trunk"
+  type           = "mpo"
+  positions      = 12
+  device_type_id = netbox_device_type.patch_panel.id
+}
+
+resource "netbox_front_port_template" "port" {
+  count                 = 12
+  name                  = "Port ${count.index + 1}"
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.trunk.id
+  rear_port_position    = count.index + 1
+  device_type_id        = netbox_device_type.patch_panel.id
+}
@@ -0,0 +1,11 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_inventory_item_template" "optic" {
+  name            = "Optic 1"
+  role_id         = netbox_inventory_item_role.optic.id
+  manufacturer_id = netbox_manufacturer.cisco.id
+  part_id         = "SFP-10G-SR"
+  component_type  = "dcim.interfacetemplate"
+  component_id    = netbox_interface_template.te1.id
+  device_type_id  = netbox_device_type.switch.id
+}
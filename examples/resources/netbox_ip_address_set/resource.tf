@@ -0,0 +1,14 @@
+resource "netbox_ip_address_set" "loopbacks" {
+  vrf_id     = netbox_vrf.underlay.id
+  tags       = ["loopback"]
+  batch_size = 250
+
+  dynamic "ip_address" {
+    for_each = range(1, 1001)
+    content {
+      address  = "${cidrhost("10.255.0.0/16", ip_address.value)}/32"
+      dns_name = "lo0.leaf${ip_address.value}.example.com"
+      role     = "loopback"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_device_interface" "eth0" {
+  name      = "eth0"
+  type      = "1000base-t"
+  device_id = netbox_device.server.id
+}
+
+resource "netbox_mac_address" "eth0" {
+  mac_address         = "00:1a:2b:3c:4d:5e"
+  device_interface_id = netbox_device_interface.eth0.id
+  primary             = true
+}
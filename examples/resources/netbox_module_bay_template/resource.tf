@@ -0,0 +1,8 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_module_bay_template" "slot" {
+  count          = 4
+  name           = "Slot ${count.index + 1}"
+  position       = count.index + 1
+  device_type_id = netbox_device_type.chassis.id
+}
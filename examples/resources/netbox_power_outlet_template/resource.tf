@@ -0,0 +1,16 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_power_port_template" "inlet" {
+  name           = "Inlet"
+  type           = "iec-60320-c20"
+  device_type_id = netbox_device_type.pdu.id
+}
+
+resource "netbox_power_outlet_template" "outlet" {
+  count                  = 8
+  name                   = "Outlet ${count.index + 1}"
+  type                   = "iec-60320-c13"
+  power_port_template_id = netbox_power_port_template.inlet.id
+  feed_leg               = "A"
+  device_type_id         = netbox_device_type.pdu.id
+}
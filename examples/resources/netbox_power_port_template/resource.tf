@@ -0,0 +1,9 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_power_port_template" "psu1" {
+  name           = "PSU1"
+  type           = "iec-60320-c14"
+  maximum_draw   = 750
+  allocated_draw = 400
+  device_type_id = netbox_device_type.server.id
+}
@@ -0,0 +1,25 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_manufacturer" "apc" {
+  name = "APC"
+}
+
+resource "netbox_rack_type" "netshelter" {
+  model           = "NetShelter SX 48U"
+  manufacturer_id = netbox_manufacturer.apc.id
+  form_factor     = "4-post-cabinet"
+  width           = 19
+  u_height        = 48
+  outer_width     = 750
+  outer_depth     = 1200
+  outer_unit      = "mm"
+}
+
+resource "netbox_rack" "rack" {
+  name         = "R101"
+  site_id      = netbox_site.site.id
+  status       = "active"
+  width        = netbox_rack_type.netshelter.width
+  u_height     = netbox_rack_type.netshelter.u_height
+  rack_type_id = netbox_rack_type.netshelter.id
+}
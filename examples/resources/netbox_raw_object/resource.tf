@@ -0,0 +1,14 @@
+resource "netbox_raw_object" "l2vpn" {
+  path = "vpn/l2vpns"
+  payload = jsonencode({
+    name       = "customer-a"
+    slug       = "customer-a"
+    type       = "vxlan"
+    identifier = 10001
+    tenant     = netbox_tenant.customer_a.id
+  })
+}
+
+output "l2vpn_url" {
+  value = jsondecode(netbox_raw_object.l2vpn.object).url
+}
@@ -0,0 +1,8 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_rear_port_template" "trunk" {
+  name           = "Trunk"
+  type           = "mpo"
+  positions      = 12
+  device_type_id = netbox_device_type.patch_panel.id
+}
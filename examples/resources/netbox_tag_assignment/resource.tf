@@ -0,0 +1,12 @@
+data "netbox_devices" "discovered" {
+  filter {
+    name  = "site_id"
+    value = netbox_site.frankfurt.id
+  }
+}
+
+resource "netbox_tag_assignment" "in_scope" {
+  tag          = netbox_tag.in_scope.name
+  content_type = "dcim.device"
+  object_ids   = data.netbox_devices.discovered.devices[*].device_id
+}
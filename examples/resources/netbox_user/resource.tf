@@ -4,3 +4,10 @@ resource "netbox_user" "test" {
   active   = true
   staff    = true
 }
+
+# With Terraform 1.11 or later, the password can be kept out of the state
+resource "netbox_user" "write_only" {
+  username            = "janedoe"
+  password_wo         = var.janedoe_password
+  password_wo_version = 1
+}
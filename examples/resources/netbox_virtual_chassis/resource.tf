@@ -3,3 +3,17 @@ resource "netbox_virtual_chassis" "example" {
   domain      = "domain"
   description = "virtual chassis"
 }
+
+resource "netbox_virtual_chassis" "stack" {
+  name      = "sw-stack01"
+  master_id = netbox_device.sw[0].id
+
+  dynamic "member" {
+    for_each = netbox_device.sw
+    content {
+      device_id = member.value.id
+      position  = member.key + 1
+      priority  = member.key == 0 ? 255 : 128
+    }
+  }
+}
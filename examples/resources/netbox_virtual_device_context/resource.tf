@@ -0,0 +1,6 @@
+resource "netbox_virtual_device_context" "admin" {
+  name       = "admin"
+  device_id  = netbox_device.firewall.id
+  identifier = 1
+  tenant_id  = netbox_tenant.network.id
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/e-breuninger/terraform-provider-netbox/netbox"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -14,17 +15,24 @@ import (
 // can be customized.
 //go:generate go run github.com/fbreckle/terraform-plugin-docs/cmd/tfplugindocs
 
+// version is set by goreleaser at build time.
+var version = "dev"
+
 func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		Debug:        debug,
-		ProviderAddr: "registry.terraform.io/e-breuninger/netbox",
-		ProviderFunc: func() *schema.Provider {
-			return netbox.Provider()
-		},
-	})
+	netbox.Version = version
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	err := netbox.Serve(context.Background(), "registry.terraform.io/e-breuninger/netbox", serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
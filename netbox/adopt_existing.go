@@ -0,0 +1,88 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const adoptExistingKey = "adopt_existing"
+
+// wrapAdoptExisting adds the adopt_existing attribute to the given resource.
+// When it is set and creating the object fails, e.g. because an object with
+// the same slug already exists, the existing object is looked up by the keys
+// of the resource identity and adopted instead. The adopted object is then
+// updated to match the configuration. It must be applied after
+// wrapAPIErrors.
+func wrapAdoptExisting(r *schema.Resource, path string, keys []identityKey) {
+	var names []string
+	for _, key := range keys {
+		names = append(names, key.name)
+	}
+	r.Schema[adoptExistingKey] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: fmt.Sprintf("If `true` and the object can not be created because it already exists in Netbox, the existing object with the same %s is adopted and updated to match the configuration instead of failing. Only applies when the resource is created.", strings.Join(names, " and ")),
+	}
+
+	create := r.CreateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if !diags.HasError() || !d.Get(adoptExistingKey).(bool) {
+			return diags
+		}
+
+		api, ok := m.(*providerState)
+		if !ok {
+			return diags
+		}
+		id, err := getIDByResourceKeys(api, path, keys, d)
+		if err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "No object to adopt",
+				Detail:   fmt.Sprintf("No existing object could be adopted: %s", err),
+			})
+		}
+
+		d.SetId(strconv.FormatInt(id, 10))
+		diags = diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Existing object adopted",
+			Detail:   fmt.Sprintf("The object could not be created, so the existing object with ID %d was adopted and updated to match the configuration.", id),
+		}}
+		if r.UpdateContext != nil {
+			return append(diags, r.UpdateContext(ctx, d, m)...)
+		}
+		return append(diags, r.ReadContext(ctx, d, m)...)
+	}
+}
+
+// getIDByResourceKeys returns the ID of the only object at the given API path
+// whose identity keys match the configuration of the resource.
+func getIDByResourceKeys(api *providerState, path string, keys []identityKey, d *schema.ResourceData) (int64, error) {
+	query := url.Values{}
+	var filters []string
+	for _, key := range keys {
+		if key.reference == "" {
+			value := d.Get(key.attribute).(string)
+			query.Set(key.name, value)
+			filters = append(filters, key.name+"="+value)
+			continue
+		}
+
+		value := "null"
+		if id := d.Get(key.reference + "_id").(int); id != 0 {
+			value = strconv.Itoa(id)
+		}
+		query.Set(key.reference+"_id", value)
+		filters = append(filters, key.reference+"_id="+value)
+	}
+	return getIDByQuery(api, path, query, strings.Join(filters, ","))
+}
@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapAdoptExisting(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/extras/tags/", r.URL.Path)
+		if r.URL.Query().Get("slug") == "k8s-node" {
+			w.Write([]byte(`{"count": 1, "results": [{"id": 5}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	updated := ""
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return errors.New("tag with this slug already exists")
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			updated = d.Id()
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapAdoptExisting(r, "/extras/tags/", resourceIdentities["netbox_tag"])
+	assert.Contains(t, r.Schema, adoptExistingKey)
+
+	for _, tc := range []struct {
+		name     string
+		slug     string
+		adopt    bool
+		expected string
+	}{
+		{"Disabled", "k8s-node", false, ""},
+		{"Adopted", "k8s-node", true, "5"},
+		{"NotFound", "web", true, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			updated = ""
+			d := r.Data(nil)
+			d.Set("slug", tc.slug)
+			d.Set(adoptExistingKey, tc.adopt)
+			diags := r.CreateContext(context.Background(), d, api)
+			assert.Equal(t, tc.expected, d.Id())
+			assert.Equal(t, tc.expected, updated)
+			assert.Equal(t, tc.expected == "", diags.HasError())
+			if tc.expected != "" {
+				assert.Equal(t, diag.Warning, diags[0].Severity)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+// apiErrorPattern matches the messages of errors returned by go-netbox, like
+// "[POST /dcim/sites/][400] dcim_sites_create default {...}", and by
+// apiRequest, like "[POST /dcim/sites/][400] {...}".
+var apiErrorPattern = regexp.MustCompile(`^\[([A-Z]+) ([^\]]*)\]\[(\d{3})\](?: \S+ default)? (\{.*\})$`)
+
+// nonFieldErrorKeys are the keys Netbox uses for errors that do not belong to
+// a single field.
+var nonFieldErrorKeys = []string{"detail", "non_field_errors", "__all__"}
+
+// wrapAPIErrors makes all CRUD functions of the given resource return
+// diagnostics that show the validation errors in Netbox error responses
+// instead of the raw response. objectPath returns the API path of the object
+// of the resource, see withNotFoundRemoval. It is nil for data sources and
+// resources whose ID is not the ID of a Netbox object.
+func wrapAPIErrors(r *schema.Resource, objectPath func(*schema.ResourceData) string) {
+	if r.Create != nil {
+		r.CreateContext = legacyContextFunc(r.Create)
+		r.Create = nil
+	}
+	if r.Read != nil {
+		r.ReadContext = legacyContextFunc(r.Read)
+		r.Read = nil
+	}
+	if r.Update != nil {
+		r.UpdateContext = legacyContextFunc(r.Update)
+		r.Update = nil
+	}
+	if r.Delete != nil {
+		r.DeleteContext = legacyContextFunc(r.Delete)
+		r.Delete = nil
+	}
+
+	r.CreateContext = withAPIErrorDiagnostics(r, r.CreateContext)
+	r.ReadContext = withAPIErrorDiagnostics(r, withNotFoundRemoval(r.ReadContext, objectPath))
+	r.UpdateContext = withAPIErrorDiagnostics(r, withOperationRetry(r.UpdateContext))
+	r.DeleteContext = withAPIErrorDiagnostics(r, withOperationRetry(r.DeleteContext))
+}
+
+func legacyContextFunc(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, m))
+	}
+}
+
+func withAPIErrorDiagnostics(r *schema.Resource, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		var result diag.Diagnostics
+		for _, diagnostic := range diags {
+			result = append(result, apiErrorDiagnostics(r.Schema, diagnostic)...)
+		}
+		return result
+	}
+}
+
+// objectPathFunc returns the function that returns the API path of the
+// object of the resource with the given name, like "/dcim/sites/7/", or nil
+// if the ID of the resource is not the ID of a Netbox object.
+func objectPathFunc(name string) func(*schema.ResourceData) string {
+	switch name {
+	case "netbox_ip_address_set", "netbox_tag_assignment":
+		return nil
+	case "netbox_raw_object":
+		return func(d *schema.ResourceData) string {
+			return rawObjectPath(d.Get("path").(string)) + d.Id() + "/"
+		}
+	}
+
+	path, ok := importPaths[name]
+	if !ok {
+		return nil
+	}
+	return func(d *schema.ResourceData) string {
+		return path + d.Id() + "/"
+	}
+}
+
+// withNotFoundRemoval removes a resource from the state with a warning if its
+// object no longer exists in Netbox, e.g. because it was deleted outside of
+// Terraform. This covers both reads that answer a 404 response by removing
+// the resource themselves and reads that return the error for the path
+// returned by objectPath. A 404 for any other object, e.g. a related one,
+// is returned as an error.
+func withNotFoundRemoval(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, objectPath func(*schema.ResourceData) string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		id := d.Id()
+		path := ""
+		if objectPath != nil && id != "" {
+			path = objectPath(d)
+		}
+		diags := f(ctx, d, m)
+		if id == "" {
+			return diags
+		}
+
+		var result diag.Diagnostics
+		for _, diagnostic := range diags {
+			if path != "" && isNotFoundDiagnostic(diagnostic, path, id) {
+				d.SetId("")
+				continue
+			}
+			result = append(result, diagnostic)
+		}
+
+		if d.Id() == "" && !result.HasError() {
+			result = append(result, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Object not found in Netbox",
+				Detail:   fmt.Sprintf("The object with ID %s no longer exists in Netbox, it was probably deleted outside of Terraform. It has been removed from the state.", id),
+			})
+		}
+		return result
+	}
+}
+
+// isNotFoundDiagnostic reports whether the diagnostic was created from a 404
+// response to a GET request for the given path of the object with the given
+// ID. go-netbox reports the path with an "{id}" placeholder.
+func isNotFoundDiagnostic(diagnostic diag.Diagnostic, path, id string) bool {
+	match := apiErrorPattern.FindStringSubmatch(diagnostic.Summary)
+	if diagnostic.Severity != diag.Error || match == nil || match[1] != "GET" || match[3] != "404" {
+		return false
+	}
+	return strings.Replace(match[2], "{id}", id, 1) == path
+}
+
+// apiErrorDiagnostics splits a diagnostic created from a 4xx Netbox error
+// response into one diagnostic per rejected field. Where a field matches an
+// attribute of the resource, the diagnostic is attributed to it. All other
+// diagnostics are returned unchanged.
+func apiErrorDiagnostics(resourceSchema map[string]*schema.Schema, diagnostic diag.Diagnostic) diag.Diagnostics {
+	unchanged := diag.Diagnostics{diagnostic}
+	if diagnostic.Severity != diag.Error || len(diagnostic.AttributePath) > 0 {
+		return unchanged
+	}
+
+	match := apiErrorPattern.FindStringSubmatch(diagnostic.Summary)
+	if match == nil || !strings.HasPrefix(match[3], "4") {
+		return unchanged
+	}
+	method, path, code := match[1], match[2], match[3]
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(match[4]), &payload); err != nil || len(payload) == 0 {
+		return unchanged
+	}
+
+	fields := make([]string, 0, len(payload))
+	for field := range payload {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	request := fmt.Sprintf("Netbox answered %s %s with status %s.", method, path, code)
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		messages := apiErrorMessages(payload[field])
+
+		if slices.Contains(nonFieldErrorKeys, field) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Netbox API error: " + strings.Join(messages, " "),
+				Detail:   request,
+			})
+			continue
+		}
+
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Netbox rejected field %s: %s", field, strings.Join(messages, " ")),
+			Detail:   request,
+		}
+		if attribute := attributeForAPIField(resourceSchema, field); attribute != "" {
+			d.AttributePath = cty.GetAttrPath(attribute)
+		}
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+// apiErrorMessages returns the messages of a field in a Netbox error response.
+// Usually this is a list of strings, but errors of nested objects are maps or
+// lists of maps, which are returned as JSON.
+func apiErrorMessages(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		messages := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				messages = append(messages, s)
+				continue
+			}
+			itemJSON, _ := json.Marshal(item)
+			messages = append(messages, string(itemJSON))
+		}
+		return messages
+	}
+	valueJSON, _ := json.Marshal(value)
+	return []string{string(valueJSON)}
+}
+
+// attributeForAPIField returns the attribute of the resource that sets the
+// given field of the Netbox API. References to other objects are usually
+// called <field>_id or <field>_ids in this provider.
+func attributeForAPIField(resourceSchema map[string]*schema.Schema, field string) string {
+	for _, attribute := range []string{field, field + "_id", field + "_ids"} {
+		if _, ok := resourceSchema[attribute]; ok {
+			return attribute
+		}
+	}
+	return ""
+}
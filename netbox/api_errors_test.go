@@ -0,0 +1,144 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapAPIErrors(t *testing.T) {
+	apiErr := dcim.NewDcimSitesCreateDefault(400)
+	apiErr.Payload = map[string]interface{}{
+		"slug":             []interface{}{"site with this slug already exists."},
+		"tenant":           []interface{}{"Related object not found using the provided numeric ID: 7"},
+		"non_field_errors": []interface{}{"The fields name, region must make a unique set."},
+		"status":           []interface{}{"\"foo\" is not a valid choice."},
+		"custom_fields":    map[string]interface{}{"owner": []interface{}{"This field is required."}},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"slug":          {Type: schema.TypeString, Optional: true},
+			"tenant_id":     {Type: schema.TypeInt, Optional: true},
+			"custom_fields": {Type: schema.TypeMap, Optional: true},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return apiErr
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return nil
+		},
+	}
+	wrapAPIErrors(r, nil)
+	assert.Nil(t, r.Create)
+	assert.Nil(t, r.ReadContext)
+
+	diags := r.CreateContext(context.Background(), r.TestResourceData(), nil)
+	assert.Len(t, diags, 5)
+
+	summaries := map[string]cty.Path{}
+	for _, d := range diags {
+		assert.Equal(t, diag.Error, d.Severity)
+		assert.Equal(t, "Netbox answered POST /dcim/sites/ with status 400.", d.Detail)
+		summaries[d.Summary] = d.AttributePath
+	}
+	assert.Equal(t, map[string]cty.Path{
+		`Netbox rejected field custom_fields: {"owner":["This field is required."]}`:              cty.GetAttrPath("custom_fields"),
+		"Netbox API error: The fields name, region must make a unique set.":                       nil,
+		"Netbox rejected field slug: site with this slug already exists.":                         cty.GetAttrPath("slug"),
+		`Netbox rejected field status: "foo" is not a valid choice.`:                              nil,
+		"Netbox rejected field tenant: Related object not found using the provided numeric ID: 7": cty.GetAttrPath("tenant_id"),
+	}, summaries)
+
+	assert.Nil(t, r.DeleteContext(context.Background(), r.TestResourceData(), nil))
+}
+
+func TestAPIErrorDiagnosticsUnchanged(t *testing.T) {
+	for _, err := range []error{
+		errors.New("no site found matching filter"),
+		errors.New(`[GET /dcim/sites/{id}/][500] dcim_sites_read default {"error": "boom"}`),
+		errors.New(`[GET /dcim/sites/][400] ["unexpected"]`),
+	} {
+		diags := apiErrorDiagnostics(nil, diag.FromErr(err)[0])
+		assert.Len(t, diags, 1)
+		assert.Equal(t, err.Error(), diags[0].Summary)
+	}
+}
+
+func TestAPIErrorDiagnosticsAPIRequest(t *testing.T) {
+	err := &apiRequestError{method: "POST", path: "/users/tokens/provision/", statusCode: 403, payload: map[string]interface{}{"detail": "Invalid credentials."}}
+
+	diags := apiErrorDiagnostics(nil, diag.FromErr(err)[0])
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Netbox API error: Invalid credentials.", diags[0].Summary)
+}
+
+func TestWrapAPIErrorsNotFound(t *testing.T) {
+	for name, tc := range map[string]struct {
+		read    schema.ReadFunc
+		removed bool
+	}{
+		"error": {func(d *schema.ResourceData, m interface{}) error {
+			apiErr := dcim.NewDcimSitesReadDefault(404)
+			apiErr.Payload = map[string]interface{}{"detail": "Not found."}
+			return apiErr
+		}, true},
+		"request error": {func(d *schema.ResourceData, m interface{}) error {
+			return &apiRequestError{method: "GET", path: "/dcim/sites/7/", statusCode: 404, payload: map[string]interface{}{"detail": "Not found."}}
+		}, true},
+		"removed": {func(d *schema.ResourceData, m interface{}) error {
+			d.SetId("")
+			return nil
+		}, true},
+		"related object": {func(d *schema.ResourceData, m interface{}) error {
+			apiErr := dcim.NewDcimRegionsReadDefault(404)
+			apiErr.Payload = map[string]interface{}{"detail": "Not found."}
+			return apiErr
+		}, false},
+		"other site": {func(d *schema.ResourceData, m interface{}) error {
+			return &apiRequestError{method: "GET", path: "/dcim/sites/8/", statusCode: 404, payload: map[string]interface{}{"detail": "Not found."}}
+		}, false},
+	} {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			},
+			Read: tc.read,
+		}
+		wrapAPIErrors(r, objectPathFunc("netbox_site"))
+
+		d := r.TestResourceData()
+		d.SetId("7")
+		diags := r.ReadContext(context.Background(), d, nil)
+
+		assert.Len(t, diags, 1, name)
+		if !tc.removed {
+			assert.Equal(t, "7", d.Id(), name)
+			assert.Equal(t, diag.Error, diags[0].Severity, name)
+			continue
+		}
+		assert.Equal(t, "", d.Id(), name)
+		assert.Equal(t, diag.Warning, diags[0].Severity, name)
+		assert.Contains(t, diags[0].Detail, "ID 7", name)
+	}
+}
+
+func TestWrapAPIErrorsNotFoundOnCreate(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dcim.NewDcimSitesReadDefault(404)
+		},
+	}
+	wrapAPIErrors(r, nil)
+
+	d := r.TestResourceData()
+	diags := r.ReadContext(context.Background(), d, nil)
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,208 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// apiRequestError is returned by apiRequest if Netbox answers with a non-2xx
+// status code.
+type apiRequestError struct {
+	method     string
+	path       string
+	statusCode int
+	payload    interface{}
+}
+
+func (e *apiRequestError) Error() string {
+	payload, _ := json.Marshal(e.payload)
+	return fmt.Sprintf("[%s %s][%d] %s", e.method, e.path, e.statusCode, payload)
+}
+
+type apiRequestParams struct {
+	query url.Values
+	body  interface{}
+}
+
+func (p *apiRequestParams) WriteToRequest(r runtime.ClientRequest, _ strfmt.Registry) error {
+	for key, values := range p.query {
+		if err := r.SetQueryParam(key, values...); err != nil {
+			return err
+		}
+	}
+	if p.body != nil {
+		return r.SetBodyParam(p.body)
+	}
+	return nil
+}
+
+// apiUploadParams are the files of an upload by form field name.
+type apiUploadParams map[string]string
+
+func (p apiUploadParams) WriteToRequest(r runtime.ClientRequest, _ strfmt.Registry) error {
+	var names []string
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file, err := os.Open(p[name])
+		if err != nil {
+			return err
+		}
+		// The file is closed by the runtime once it is sent
+		if err := r.SetFileParam(name, file); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// apiRequest sends a request to an endpoint that is not covered by go-netbox.
+// pathPattern is relative to the API base path (/api) and the decoded JSON
+// response is returned. It uses the transport of the given client, so all
+// provider settings like authentication, headers and retries apply.
+func apiRequest(api *providerState, method, pathPattern string, query url.Values, body interface{}) (interface{}, error) {
+	return submitAPIRequest(api, method, pathPattern, runtime.JSONMime, &apiRequestParams{query: query, body: body})
+}
+
+// apiUpload sends the given local files as multipart form to Netbox, e.g. to
+// set the images of a device type, and returns the decoded JSON response.
+// files maps the names of the fields to the paths of the files.
+func apiUpload(api *providerState, method, pathPattern string, files map[string]string) (interface{}, error) {
+	return submitAPIRequest(api, method, pathPattern, runtime.MultipartFormMime, apiUploadParams(files))
+}
+
+func submitAPIRequest(api *providerState, method, pathPattern, mediaType string, params runtime.ClientRequestWriter) (interface{}, error) {
+	op := &runtime.ClientOperation{
+		ID:                 "api_request",
+		Method:             method,
+		PathPattern:        pathPattern,
+		ProducesMediaTypes: []string{runtime.JSONMime},
+		ConsumesMediaTypes: []string{mediaType},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			var payload interface{}
+			if err := consumer.Consume(response.Body(), &payload); err != nil && err != io.EOF {
+				return nil, err
+			}
+			if response.Code()/100 != 2 {
+				return nil, &apiRequestError{
+					method:     method,
+					path:       pathPattern,
+					statusCode: response.Code(),
+					payload:    payload,
+				}
+			}
+			return payload, nil
+		}),
+	}
+
+	return api.Transport.Submit(op)
+}
+
+// apiList returns the results of a list endpoint that is not covered by
+// go-netbox, walking all pages from the given offset. If limit is greater
+// than zero, at most limit results are returned.
+func apiList(api *providerState, path string, query url.Values, limit, offset int) ([]interface{}, error) {
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+
+	return listAll(int64(limit), int64(offset), func(limit, offset int64) ([]interface{}, int64, error) {
+		pageQuery.Set("limit", strconv.FormatInt(limit, 10))
+		pageQuery.Set("offset", strconv.FormatInt(offset, 10))
+
+		res, err := apiRequest(api, http.MethodGet, path, pageQuery, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		payload, ok := res.(map[string]interface{})
+		if !ok {
+			return nil, 0, fmt.Errorf("unexpected response of %s: %v", path, res)
+		}
+		results, _ := payload["results"].([]interface{})
+		count, _ := payload["count"].(json.Number)
+		total, _ := count.Int64()
+		return results, total, nil
+	})
+}
+
+// apiBulkRequest sends the objects to a bulk endpoint of Netbox, e.g. POST,
+// PATCH or DELETE to /ipam/ip-addresses/, in batches of at most batchSize
+// objects. It returns the objects returned by Netbox for all batches sent
+// until the first error.
+func apiBulkRequest(api *providerState, method, path string, objects []map[string]interface{}, batchSize int) ([]interface{}, error) {
+	var results []interface{}
+	for start := 0; start < len(objects); start += batchSize {
+		end := min(start+batchSize, len(objects))
+		res, err := apiRequest(api, method, path, nil, objects[start:end])
+		if err != nil {
+			return results, err
+		}
+		batch, _ := res.([]interface{})
+		results = append(results, batch...)
+	}
+	return results, nil
+}
+
+type queryParamsWriter struct {
+	params runtime.ClientRequestWriter
+	query  url.Values
+}
+
+func (w queryParamsWriter) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if err := w.params.WriteToRequest(r, reg); err != nil {
+		return err
+	}
+	for key, values := range w.query {
+		if err := r.SetQueryParam(key, values...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withQueryParams returns a go-netbox client option that adds the given query
+// parameters to the request, e.g. filters that go-netbox does not know.
+func withQueryParams(query url.Values) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		if len(query) > 0 {
+			op.Params = queryParamsWriter{params: op.Params, query: query}
+		}
+	}
+}
+
+type requestBodyWriter struct {
+	params runtime.ClientRequestWriter
+	body   interface{}
+}
+
+func (w requestBodyWriter) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if err := w.params.WriteToRequest(r, reg); err != nil {
+		return err
+	}
+	return r.SetBodyParam(w.body)
+}
+
+// withRequestBody returns a go-netbox client option that replaces the body of
+// the request, e.g. by a wrapper of the go-netbox model that adds fields
+// go-netbox does not know.
+func withRequestBody(body interface{}) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Params = requestBodyWriter{params: op.Params, body: body}
+	}
+}
@@ -0,0 +1,204 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/rack-types/", r.URL.Path)
+		assert.Equal(t, "foo", r.URL.Query().Get("slug"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 1, "results": [{"id": 1}]}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	res, err := apiRequest(api, "GET", "/dcim/rack-types/", url.Values{"slug": {"foo"}}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("1"), res.(map[string]interface{})["count"])
+}
+
+func TestAPIRequestOutsideOfAPIPath(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/netbox/graphql/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {}}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL + "/netbox",
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	_, err = apiRequest(api, "POST", "/../graphql/", nil, map[string]interface{}{"query": "{}"})
+	assert.NoError(t, err)
+}
+
+func TestAPIRequestError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"name": ["This field is required."]}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	_, err = apiRequest(api, "POST", "/dcim/rack-types/", nil, map[string]interface{}{})
+	assert.EqualError(t, err, `[POST /dcim/rack-types/][400] {"name":["This field is required."]}`)
+}
+
+func TestAPIList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/sites/", r.URL.Path)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		// Pages of two objects, like with a low MAX_PAGE_SIZE
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			w.Write([]byte(`{"count": 3, "next": "http://netbox/api/dcim/sites/?offset=2", "results": [{"id": 1}, {"id": 2}]}`))
+		case "2":
+			w.Write([]byte(`{"count": 3, "next": null, "results": [{"id": 3}]}`))
+		default:
+			t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	results, err := apiList(api, "/dcim/sites/", url.Values{"status": {"active"}}, 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	results, err = apiList(api, "/dcim/sites/", url.Values{"status": {"active"}}, 1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	results, err = apiList(api, "/dcim/sites/", url.Values{"status": {"active"}}, 0, 2)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+}
+
+func TestAPIUpload(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/dcim/device-types/1/", r.URL.Path)
+		file, header, err := r.FormFile("front_image")
+		if assert.NoError(t, err) {
+			defer file.Close()
+			content := make([]byte, 16)
+			n, _ := file.Read(content)
+			assert.Equal(t, "front.png", header.Filename)
+			assert.Equal(t, "front", string(content[:n]))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "front_image": "http://netbox/media/devicetype-images/front.png"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	path := filepath.Join(t.TempDir(), "front.png")
+	assert.NoError(t, os.WriteFile(path, []byte("front"), 0600))
+
+	res, err := apiUpload(api, http.MethodPatch, "/dcim/device-types/1/", map[string]string{"front_image": path})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://netbox/media/devicetype-images/front.png", res.(map[string]interface{})["front_image"])
+
+	_, err = apiUpload(api, http.MethodPatch, "/dcim/device-types/1/", map[string]string{"front_image": path + ".missing"})
+	assert.Error(t, err)
+}
+
+func TestWithQueryParams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/sites/", r.URL.Path)
+		assert.Equal(t, "frankfurt", r.URL.Query().Get("slug"))
+		assert.Equal(t, []string{"a", "b"}, r.URL.Query()["cf_owner"])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	params := dcim.NewDcimSitesListParams().WithSlug(strToPtr("frankfurt"))
+	_, err = api.Dcim.DcimSitesList(params, nil, withQueryParams(url.Values{"cf_owner": {"a", "b"}}))
+	assert.NoError(t, err)
+}
+
+func TestWithRequestBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/sites/1/", r.URL.Path)
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "frankfurt", body["name"])
+		assert.Equal(t, "extra", body["extra"])
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "name": "frankfurt"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	data := models.WritableSite{Name: strToPtr("frankfurt")}
+	body := struct {
+		models.WritableSite
+		Extra string `json:"extra"`
+	}{WritableSite: data, Extra: "extra"}
+	params := dcim.NewDcimSitesUpdateParams().WithID(1).WithData(&data)
+	_, err = api.Dcim.DcimSitesUpdate(params, nil, withRequestBody(&body))
+	assert.NoError(t, err)
+}
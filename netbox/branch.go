@@ -0,0 +1,135 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const branchKey = "branch"
+
+// branchDescription is the description of the branch attribute of all
+// resources.
+const branchDescription = "Name of a branch of the [netbox-branching](https://github.com/netboxlabs/netbox-branching) plugin to manage the object in, instead of the `branch` of the provider. Once the branch is merged, remove the attribute to manage the object in main."
+
+// forBranch returns the state of the provider with a client that sends all
+// requests to the netbox-branching branch with the given name. The clients
+// are built once per branch on top of the client of the provider, so all
+// branches share its connections, concurrency limit, retries and request
+// cache. Without a name, the state itself is returned.
+func (s *providerState) forBranch(name string) (*providerState, error) {
+	if name == "" {
+		return s, nil
+	}
+	if state, ok := s.branches.Load(name); ok {
+		return state.(*providerState), nil
+	}
+	if _, offline := s.Transport.(offlineTransport); offline {
+		return nil, fmt.Errorf("branch %s can not be used without a connection to Netbox", name)
+	}
+
+	schemaID, err := getBranchSchemaID(s, name)
+	if err != nil {
+		return nil, err
+	}
+
+	state, _ := s.branches.LoadOrStore(name, &providerState{
+		NetBoxAPI:                   branchClient(s.NetBoxAPI, schemaID),
+		defaultTags:                 s.defaultTags,
+		ignoreUnmanagedCustomFields: s.ignoreUnmanagedCustomFields,
+		detectExternalModifications: s.detectExternalModifications,
+	})
+	return state.(*providerState), nil
+}
+
+// branchClient returns a client that sends all requests of api to the branch
+// with the given schema ID.
+func branchClient(api *netboxclient.NetBoxAPI, schemaID string) *netboxclient.NetBoxAPI {
+	return netboxclient.New(branchClientTransport{
+		original: api.Transport,
+		schemaID: schemaID,
+	}, nil)
+}
+
+// branchClientTransport is a client transport that adds the header of a
+// branch to all requests before submitting them with the original transport.
+type branchClientTransport struct {
+	original runtime.ClientTransport
+	schemaID string
+}
+
+// Submit sends the operation to the branch. A branch that was already set,
+// by a client built on top of this one, takes precedence, so resources can
+// use another branch than the provider.
+func (t branchClientTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	branchOp := *op
+	branchOp.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, registry strfmt.Registry) error {
+		if op.Params != nil {
+			if err := op.Params.WriteToRequest(r, registry); err != nil {
+				return err
+			}
+		}
+		if r.GetHeaderParams().Get(branchHeader) != "" {
+			return nil
+		}
+		return r.SetHeaderParam(branchHeader, t.schemaID)
+	})
+	return t.original.Submit(&branchOp)
+}
+
+// branchState returns the state of the provider for the branch set on a
+// resource, m itself if the resource has none.
+func branchState(m interface{}, name string) (interface{}, error) {
+	if name == "" {
+		return m, nil
+	}
+	api, ok := m.(*providerState)
+	if !ok {
+		return nil, fmt.Errorf("branch %s can not be used without a connection to Netbox", name)
+	}
+	return api.forBranch(name)
+}
+
+// withBranch runs f with the state of the provider for the branch of the
+// resource.
+func withBranch(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		api, err := branchState(m, d.Get(branchKey).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, api)
+	}
+}
+
+// wrapBranch adds the branch attribute to the given resource, which sends
+// all requests of the resource to another branch than the one of the
+// provider. It must be applied after all other wrappers, so they use the
+// branch as well.
+func wrapBranch(r *schema.Resource) {
+	r.Schema[branchKey] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: branchDescription,
+	}
+
+	r.CreateContext = withBranch(r.CreateContext)
+	r.ReadContext = withBranch(r.ReadContext)
+	r.UpdateContext = withBranch(r.UpdateContext)
+	r.DeleteContext = withBranch(r.DeleteContext)
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			api, err := branchState(m, d.Get(branchKey).(string))
+			if err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, api)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapBranch(t *testing.T) {
+	lookups := 0
+	var branchHeaders []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/plugins/branching/branches/":
+			lookups++
+			assert.Equal(t, "feature", r.URL.Query().Get("name"))
+			w.Write([]byte(`{"count": 1, "results": [{"id": 1, "name": "feature", "schema_id": "td5smq0f"}]}`))
+		case "/api/dcim/sites/1/":
+			branchHeaders = append(branchHeaders, r.Header.Get(branchHeader))
+			w.Write([]byte(`{"id": 1}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			_, err := apiRequest(m.(*providerState), "GET", "/dcim/sites/1/", nil, nil)
+			return err
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapBranch(r)
+
+	for _, branch := range []string{"", "feature", "feature"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{branchKey: branch})
+		d.SetId("1")
+		diags := r.ReadContext(context.Background(), d, api)
+		assert.False(t, diags.HasError(), branch)
+	}
+
+	assert.Equal(t, []string{"", "td5smq0f", "td5smq0f"}, branchHeaders)
+	assert.Equal(t, 1, lookups)
+
+	// Branch clients send their requests with the transport of the provider
+	state, err := api.forBranch("feature")
+	assert.NoError(t, err)
+	assert.Equal(t, netboxClient.Transport, state.Transport.(branchClientTransport).original)
+
+	// The branch of a resource takes precedence over the one of the provider
+	branchHeaders = nil
+	providerBranch := &providerState{NetBoxAPI: branchClient(netboxClient, "main0000")}
+	for _, branch := range []string{"", "feature"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{branchKey: branch})
+		d.SetId("1")
+		diags := r.ReadContext(context.Background(), d, providerBranch)
+		assert.False(t, diags.HasError(), branch)
+	}
+	assert.Equal(t, []string{"main0000", "td5smq0f"}, branchHeaders)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{branchKey: "feature"})
+	d.SetId("1")
+	diags := r.ReadContext(context.Background(), d, &providerState{NetBoxAPI: offlineClient()})
+	assert.True(t, diags.HasError())
+}
@@ -1,26 +1,75 @@
 package netbox
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/goware/urlx"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 )
 
 // Config struct for the netbox provider
 type Config struct {
 	APIToken                    string
+	Username                    string
+	Password                    string
 	ServerURL                   string
 	AllowInsecureHTTPS          bool
+	CACertFile                  string
+	CACertPEM                   string
+	ClientCertFile              string
+	ClientKeyFile               string
+	ClientCertPEM               string
+	ClientKeyPEM                string
 	Headers                     map[string]interface{}
+	UserAgent                   string
+	ProxyURL                    string
 	RequestTimeout              int
+	MaxRetries                  int
+	MaxConcurrentRequests       int
+	RetryMinDelay               int
+	EnableRequestCache          bool
+	MaxIdleConns                int
+	IdleConnTimeout             int
+	DisableKeepAlives           bool
 	StripTrailingSlashesFromURL bool
+	// Context is the parent of requests that have no context of their own,
+	// so they are logged with the logger of Terraform it carries. Its
+	// cancellation is ignored.
+	Context context.Context
+	// TransportWrapper, if set, wraps the transport that sends the requests to
+	// Netbox. It is applied innermost, so it sees every retry with all headers.
+	TransportWrapper func(http.RoundTripper) http.RoundTripper
+
+	// provisionedToken is the token that Client provisioned for Username. The
+	// state of the provider takes it over, see providerState.sessionToken.
+	provisionedToken *provisionedToken
 }
 
+// branchHeader is the header the netbox-branching plugin uses to select the
+// branch a request operates on.
+const branchHeader = "X-NetBox-Branch"
+
+// retryMaxDelay caps the exponential backoff between two retries.
+const retryMaxDelay = 30 * time.Second
+
 // customHeaderTransport is a transport that adds the specified headers on
 // every request.
 type customHeaderTransport struct {
@@ -34,7 +83,7 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		"server_url": cfg.ServerURL,
 	}).Debug("Initializing Netbox client")
 
-	if cfg.APIToken == "" {
+	if cfg.APIToken == "" && cfg.Username == "" {
 		return nil, fmt.Errorf("missing netbox API key")
 	}
 
@@ -60,8 +109,76 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		return nil, err
 	}
 
+	rootCAs, err := cfg.rootCAs()
+	if err != nil {
+		return nil, err
+	}
+	if rootCAs != nil {
+		log.Debug("Adding custom CA certificates to the trusted root pool")
+		trans.(*http.Transport).TLSClientConfig.RootCAs = rootCAs
+	}
+
+	clientCert, err := cfg.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	if clientCert != nil {
+		log.Debug("Presenting client certificate to Netbox")
+		trans.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	// All requests go to the same host, so the per host limit of idle
+	// connections is the relevant one. Go's default of 2 causes a new TLS
+	// handshake for most requests when Terraform runs in parallel.
+	if cfg.MaxIdleConns > 0 {
+		trans.(*http.Transport).MaxIdleConns = cfg.MaxIdleConns
+		trans.(*http.Transport).MaxIdleConnsPerHost = cfg.MaxIdleConns
+	}
+	trans.(*http.Transport).IdleConnTimeout = time.Second * time.Duration(cfg.IdleConnTimeout)
+	trans.(*http.Transport).DisableKeepAlives = cfg.DisableKeepAlives
+
 	trans.(*http.Transport).Proxy = http.ProxyFromEnvironment
 
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to parse proxy URL: %s", err)
+		}
+
+		log.WithFields(log.Fields{
+			"proxy_url": proxyURL.Redacted(),
+		}).Debug("Sending all requests to Netbox via proxy")
+
+		trans.(*http.Transport).Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if cfg.TransportWrapper != nil {
+		trans = cfg.TransportWrapper(trans)
+	}
+
+	trans = loggingTransport{
+		original:  trans,
+		logBodies: traceLoggingEnabled(),
+	}
+
+	if cfg.MaxConcurrentRequests > 0 {
+		log.WithFields(log.Fields{
+			"max_concurrent_requests": cfg.MaxConcurrentRequests,
+		}).Debug("Limiting concurrent requests to Netbox")
+
+		trans = concurrencyLimitTransport{
+			original:  trans,
+			semaphore: make(chan struct{}, cfg.MaxConcurrentRequests),
+		}
+	}
+
+	if cfg.UserAgent != "" {
+		trans = userAgentTransport{
+			original:  trans,
+			userAgent: cfg.UserAgent,
+		}
+	}
+
 	if len(cfg.Headers) > 0 {
 		log.WithFields(log.Fields{
 			"custom_headers": cfg.Headers,
@@ -73,19 +190,197 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 		}
 	}
 
+	timeout := time.Second * time.Duration(cfg.RequestTimeout)
+
+	if cfg.MaxRetries > 0 {
+		log.WithFields(log.Fields{
+			"max_retries":     cfg.MaxRetries,
+			"retry_min_delay": cfg.RetryMinDelay,
+		}).Debug("Retrying requests to Netbox on rate limit and server errors")
+
+		// The timeout is enforced per attempt by the retry transport, otherwise
+		// the backoff between attempts would count against it
+		trans = retryTransport{
+			original:   trans,
+			maxRetries: cfg.MaxRetries,
+			minDelay:   time.Second * time.Duration(cfg.RetryMinDelay),
+			timeout:    timeout,
+		}
+		timeout = 0
+	}
+
+	if cfg.EnableRequestCache {
+		trans = cachingTransport{
+			original: trans,
+			cache:    &responseCache{entries: map[responseCacheKey]cachedResponse{}},
+		}
+	}
+
+	if timeout > 0 {
+		trans = timeoutTransport{
+			original: trans,
+			timeout:  timeout,
+		}
+	}
+
 	httpClient := &http.Client{
 		Transport: trans,
-		Timeout:   time.Second * time.Duration(cfg.RequestTimeout),
 	}
 
 	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+netboxclient.DefaultBasePath, desiredRuntimeClientSchemes, httpClient)
-	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", cfg.APIToken))
 	transport.SetLogger(log.StandardLogger())
+	if cfg.Context != nil {
+		transport.Context = context.WithoutCancel(cfg.Context)
+	}
 	netboxClient := netboxclient.New(transport, nil)
 
+	apiToken := cfg.APIToken
+	if apiToken == "" {
+		// Provisioning the token is not authenticated, so this happens before
+		// the default authentication is set
+		cfg.provisionedToken, err = cfg.provisionToken(netboxClient)
+		if err != nil {
+			return nil, err
+		}
+		apiToken = cfg.provisionedToken.key
+	}
+	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", apiToken))
+
 	return netboxClient, nil
 }
 
+// sessionTokenLifetime is the time after which a token provisioned for
+// username and password authentication expires, in case it is not deleted.
+const sessionTokenLifetime = 24 * time.Hour
+
+type provisionedToken struct {
+	id  int64
+	key string
+	// api is the client the token was provisioned with, which uses the token
+	// and sends no branch header.
+	api *netboxclient.NetBoxAPI
+}
+
+// provisionToken provisions a token for cfg.Username with the given
+// unauthenticated client.
+func (cfg *Config) provisionToken(api *netboxclient.NetBoxAPI) (*provisionedToken, error) {
+	log.WithFields(log.Fields{
+		"username": cfg.Username,
+	}).Debug("Provisioning Netbox API token")
+
+	res, err := apiRequest(&providerState{NetBoxAPI: api}, "POST", "/users/tokens/provision/", nil, map[string]interface{}{
+		"username":      cfg.Username,
+		"password":      cfg.Password,
+		"write_enabled": true,
+		"expires":       time.Now().Add(sessionTokenLifetime).UTC().Format(time.RFC3339),
+		"description":   "Provisioned by terraform-provider-netbox",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to provision API token for user %s: %s", cfg.Username, err)
+	}
+
+	payload, _ := res.(map[string]interface{})
+	key, _ := payload["key"].(string)
+	id, _ := payload["id"].(json.Number)
+	tokenID, err := id.Int64()
+	if key == "" || err != nil {
+		return nil, fmt.Errorf("unexpected response while provisioning API token for user %s", cfg.Username)
+	}
+
+	return &provisionedToken{id: tokenID, key: key, api: api}, nil
+}
+
+// revokeSessionToken deletes the token provisioned for username and password
+// authentication, if any. Tokens that cannot be deleted expire after
+// sessionTokenLifetime.
+func (s *providerState) revokeSessionToken() {
+	s.sessionTokenMu.Lock()
+	defer s.sessionTokenMu.Unlock()
+	if s.sessionToken == nil {
+		return
+	}
+
+	_, err := apiRequest(&providerState{NetBoxAPI: s.sessionToken.api}, "DELETE", fmt.Sprintf("/users/tokens/%d/", s.sessionToken.id), nil, nil)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"token_id": s.sessionToken.id,
+		}).Warnf("Unable to delete provisioned Netbox API token: %s", err)
+	}
+	s.sessionToken = nil
+}
+
+// rootCAs returns the system root pool extended by the configured CA
+// certificates. It returns nil if no CA certificate is configured.
+func (cfg *Config) rootCAs() (*x509.CertPool, error) {
+	if cfg.CACertFile == "" && cfg.CACertPEM == "" {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	caCerts := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		caCerts, err = os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to read CA certificate file: %s", err)
+		}
+	}
+
+	if !pool.AppendCertsFromPEM(caCerts) {
+		return nil, fmt.Errorf("no valid PEM encoded CA certificate found")
+	}
+
+	return pool, nil
+}
+
+// clientCertificate loads the client certificate used for mutual TLS, either
+// from files or from PEM encoded strings. It returns nil if none is configured.
+func (cfg *Config) clientCertificate() (*tls.Certificate, error) {
+	var cert tls.Certificate
+	var err error
+
+	switch {
+	case cfg.ClientCertFile != "" || cfg.ClientKeyFile != "":
+		cert, err = tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+	case cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "":
+		cert, err = tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to load client certificate: %s", err)
+	}
+
+	return &cert, nil
+}
+
+// userAgentTransport is a transport that sets the User-Agent header on every
+// request.
+type userAgentTransport struct {
+	original  http.RoundTripper
+	userAgent string
+}
+
+// RoundTrip sets the User-Agent header on the request.
+func (t userAgentTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("User-Agent", t.userAgent)
+	return t.original.RoundTrip(r)
+}
+
+// traceLoggingEnabled reports whether Terraform passes the trace log level
+// to providers via TF_LOG_PROVIDER or TF_LOG.
+func traceLoggingEnabled() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+	return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+}
+
 // RoundTrip adds the headers specified in the transport on every request.
 func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 	for key, value := range t.headers {
@@ -95,3 +390,385 @@ func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error
 	resp, err := t.original.RoundTrip(r)
 	return resp, err
 }
+
+// retryTransport is a transport that retries requests that were answered
+// with a rate limit (429) or server error (5xx) response, backing off
+// exponentially between attempts. See isRetryableStatusCode for the
+// responses on which requests that are not idempotent are retried.
+type retryTransport struct {
+	original   http.RoundTripper
+	maxRetries int
+	minDelay   time.Duration
+	timeout    time.Duration
+}
+
+// RoundTrip sends the request and retries it up to maxRetries times.
+func (t retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// Buffer the body so it can be replayed on every attempt
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := r.Context(), context.CancelFunc(func() {})
+		if t.timeout > 0 && !hasOperationTimeout(r.Context()) {
+			ctx, cancel = context.WithTimeout(r.Context(), t.timeout)
+		}
+
+		req := r.Clone(ctx)
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.original.RoundTrip(req)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		if attempt >= t.maxRetries || !isRetryableStatusCode(r.Method, resp.StatusCode) {
+			resp.Body = &onCloseBody{ReadCloser: resp.Body, onClose: cancel}
+			return resp, nil
+		}
+
+		delay := t.backoff(attempt, resp)
+		log.WithFields(log.Fields{
+			"method":      r.Method,
+			"url":         r.URL.String(),
+			"status_code": resp.StatusCode,
+			"attempt":     attempt + 1,
+			"delay":       delay.String(),
+		}).Debug("Retrying request to Netbox")
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		cancel()
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header sent by the server takes precedence over the exponential backoff.
+func (t retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	delay := t.minDelay << attempt
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		delay = time.Second * time.Duration(seconds)
+	}
+	if delay > retryMaxDelay || delay < 0 {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+// isRetryableStatusCode reports whether a request with the given method is
+// retried on a response with the given status code. Netbox may have
+// committed a POST or PATCH before answering with a generic server error, so
+// these are only retried on responses that indicate the request was not
+// processed, like rate limits and unavailable or timed out upstreams.
+func isRetryableStatusCode(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	if method == http.MethodPost || method == http.MethodPatch {
+		return false
+	}
+	return statusCode >= http.StatusInternalServerError
+}
+
+// timeoutTransport is a transport that cancels requests that take longer
+// than the request_timeout of the provider, unless they belong to a resource
+// operation with its own timeout.
+type timeoutTransport struct {
+	original http.RoundTripper
+	timeout  time.Duration
+}
+
+func (t timeoutTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if hasOperationTimeout(r.Context()) {
+		return t.original.RoundTrip(r)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), t.timeout)
+	resp, err := t.original.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &onCloseBody{ReadCloser: resp.Body, onClose: cancel}
+	return resp, nil
+}
+
+type operationTimeoutKey struct{}
+
+// withOperationTimeout returns a context for the requests of a resource
+// operation that is limited by the timeout of the operation instead of the
+// request_timeout of the provider.
+func withOperationTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return context.WithValue(ctx, operationTimeoutKey{}, true), cancel
+}
+
+func hasOperationTimeout(ctx context.Context) bool {
+	return ctx.Value(operationTimeoutKey{}) != nil
+}
+
+// onCloseBody calls onClose once the response body has been closed, e.g. to
+// release resources that are held for the duration of a request.
+type onCloseBody struct {
+	io.ReadCloser
+	onClose func()
+	once    sync.Once
+}
+
+func (b *onCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.onClose)
+	return err
+}
+
+// concurrencyLimitTransport is a transport that limits the number of requests
+// to Netbox that are in flight at the same time.
+type concurrencyLimitTransport struct {
+	original  http.RoundTripper
+	semaphore chan struct{}
+}
+
+// RoundTrip waits for a free slot before sending the request. The slot is
+// released once the response body has been closed.
+func (t concurrencyLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	release := func() { <-t.semaphore }
+
+	resp, err := t.original.RoundTrip(r)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &onCloseBody{ReadCloser: resp.Body, onClose: release}
+	return resp, nil
+}
+
+// loggingTransport is a transport that logs method, URL, status code and
+// duration of every request to Netbox with the logger of Terraform in the
+// request context. If logBodies is set, request and response bodies are
+// logged on trace level, with secrets redacted.
+type loggingTransport struct {
+	original  http.RoundTripper
+	logBodies bool
+}
+
+// RoundTrip sends the request and logs it along with its response.
+func (t loggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	fields := map[string]interface{}{
+		"method": r.Method,
+		"url":    r.URL.String(),
+	}
+
+	var requestBody []byte
+	if t.logBodies && r.Body != nil && r.Body != http.NoBody {
+		var err error
+		requestBody, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	start := time.Now()
+	resp, err := t.original.RoundTrip(r)
+	fields["duration"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Netbox API request failed", fields)
+		return nil, err
+	}
+	fields["status_code"] = resp.StatusCode
+	tflog.Debug(ctx, "Netbox API request", fields)
+
+	if t.logBodies {
+		responseBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+		fields["request_body"] = redactLogBody(requestBody)
+		fields["response_body"] = redactLogBody(responseBody)
+		tflog.Trace(ctx, "Netbox API request bodies", fields)
+	}
+
+	return resp, nil
+}
+
+// redactedLogKeys are the keys of JSON bodies whose values are not logged,
+// e.g. the password sent to provision a token and the keys of tokens.
+var redactedLogKeys = []string{"password", "key", "token"}
+
+// redactLogBody returns the body for logging. Values of redactedLogKeys are
+// replaced in JSON bodies. Other bodies, e.g. file uploads, are not logged.
+func redactLogBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	redacted, _ := json.Marshal(redactLogValue(value))
+	return string(redacted)
+}
+
+func redactLogValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, element := range v {
+			if slices.Contains(redactedLogKeys, strings.ToLower(key)) {
+				v[key] = "<redacted>"
+			} else {
+				v[key] = redactLogValue(element)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactLogValue(element)
+		}
+	}
+	return value
+}
+
+// cachingTransport is a transport that answers repeated GET requests for the
+// same URL from memory. Any other request invalidates the whole cache, so
+// objects are read back fresh after they were changed.
+//
+// The clients of all branches share the transport, see forBranch, so
+// responses are cached per branch. A change in one branch invalidates the
+// responses of all branches, because it may also change objects that the
+// other branches read from main.
+type cachingTransport struct {
+	original http.RoundTripper
+	cache    *responseCache
+}
+
+type responseCache struct {
+	mu         sync.Mutex
+	generation uint64
+	entries    map[responseCacheKey]cachedResponse
+}
+
+// responseCacheKey identifies a cached response by the branch and the URL of
+// its request.
+type responseCacheKey struct {
+	branch string
+	url    string
+}
+
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+func (c *responseCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = map[responseCacheKey]cachedResponse{}
+}
+
+// RoundTrip serves GET requests from the cache if possible.
+func (t cachingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodGet {
+		t.cache.invalidate()
+		defer t.cache.invalidate()
+		return t.original.RoundTrip(r)
+	}
+
+	key := responseCacheKey{
+		branch: r.Header.Get(branchHeader),
+		url:    r.URL.String(),
+	}
+
+	t.cache.mu.Lock()
+	entry, ok := t.cache.entries[key]
+	generation := t.cache.generation
+	t.cache.mu.Unlock()
+
+	if ok {
+		log.WithFields(log.Fields{
+			"url":    key.url,
+			"branch": key.branch,
+		}).Trace("Serving request to Netbox from cache")
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", entry.statusCode, http.StatusText(entry.statusCode)),
+			StatusCode:    entry.statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        entry.header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       r,
+		}, nil
+	}
+
+	resp, err := t.original.RoundTrip(r)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Do not store responses that may predate a write which happened while
+	// this request was in flight
+	t.cache.mu.Lock()
+	if t.cache.generation == generation {
+		t.cache.entries[key] = cachedResponse{
+			statusCode: resp.StatusCode,
+			header:     resp.Header.Clone(),
+			body:       body,
+		}
+	}
+	t.cache.mu.Unlock()
+
+	return resp, nil
+}
+
+// offlineClient returns a client that does not connect to Netbox. Every API
+// call made with it fails, so it can be used to plan configurations that do
+// not need to read from Netbox without network access or credentials.
+func offlineClient() *netboxclient.NetBoxAPI {
+	return netboxclient.New(offlineTransport{}, nil)
+}
+
+type offlineTransport struct{}
+
+func (offlineTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	return nil, fmt.Errorf("cannot send %s %s: the provider is configured with offline = true, so no requests are sent to Netbox", op.Method, op.PathPattern)
+}
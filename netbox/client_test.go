@@ -1,9 +1,26 @@
 package netbox
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client/status"
 	"github.com/stretchr/testify/assert"
@@ -87,6 +104,597 @@ func TestAdditionalHeadersSet(t *testing.T) {
 	client.Status.StatusList(req, nil)
 }
 
-/* TODO
-func TestInvalidHttpsCertificate(t *testing.T) {}
-*/
+func TestInvalidHttpsCertificate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.Error(t, err)
+}
+
+func TestInvalidHttpsCertificateAllowInsecure(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:          ts.URL,
+		AllowInsecureHTTPS: true,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * time.Second)
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 1,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.Error(t, err)
+}
+
+func TestOperationTimeoutOverridesRequestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * time.Second)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	for _, maxRetries := range []int{0, 1} {
+		config := Config{
+			APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			ServerURL:      ts.URL,
+			RequestTimeout: 1,
+			MaxRetries:     maxRetries,
+		}
+
+		client, err := config.Client()
+		assert.NoError(t, err)
+
+		ctx, cancel := withOperationTimeout(context.Background(), 5*time.Second)
+		req := status.NewStatusListParams().WithContext(ctx).WithTimeout(5 * time.Second)
+		_, err = client.Status.StatusList(req, nil)
+		cancel()
+		assert.NoError(t, err)
+
+		ctx, cancel = withOperationTimeout(context.Background(), time.Second)
+		req = status.NewStatusListParams().WithContext(ctx).WithTimeout(time.Second)
+		_, err = client.Status.StatusList(req, nil)
+		cancel()
+		assert.Error(t, err)
+	}
+}
+
+func TestRetryOnServerError(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+		MaxRetries:     3,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+		MaxRetries:     2,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestIsRetryableStatusCode(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete, http.MethodPost, http.MethodPatch} {
+		assert.True(t, isRetryableStatusCode(method, http.StatusTooManyRequests), method)
+		assert.True(t, isRetryableStatusCode(method, http.StatusServiceUnavailable), method)
+		assert.True(t, isRetryableStatusCode(method, http.StatusGatewayTimeout), method)
+		assert.False(t, isRetryableStatusCode(method, http.StatusConflict), method)
+	}
+	assert.True(t, isRetryableStatusCode(http.MethodGet, http.StatusInternalServerError))
+	assert.True(t, isRetryableStatusCode(http.MethodDelete, http.StatusInternalServerError))
+	assert.False(t, isRetryableStatusCode(http.MethodPost, http.StatusInternalServerError))
+	assert.False(t, isRetryableStatusCode(http.MethodPatch, http.StatusInternalServerError))
+}
+
+func TestProxyURLSet(t *testing.T) {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		assert.Equal(t, "netbox.example.com", r.Host)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer proxy.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: "http://netbox.example.com",
+		ProxyURL:  proxy.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+	assert.True(t, proxied)
+}
+
+// generateTestCertificate returns a PEM encoded self-signed certificate and
+// its private key.
+func generateTestCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestClientCertificatePresented(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Len(t, r.TLS.PeerCertificates, 1)
+		assert.Equal(t, "terraform", r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	config := Config{
+		APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:          ts.URL,
+		AllowInsecureHTTPS: true,
+		ClientCertPEM:      certPEM,
+		ClientKeyPEM:       keyPEM,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestClientCertificateMissingKeyShouldFail(t *testing.T) {
+	certPEM, _ := generateTestCertificate(t)
+
+	config := Config{
+		APIToken:      "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:     "https://localhost:8080",
+		ClientCertPEM: certPEM,
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func TestCustomCACertificate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	assert.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+	config := Config{
+		APIToken:   "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:  ts.URL,
+		CACertFile: caFile,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+}
+
+func TestInvalidCACertificateShouldFail(t *testing.T) {
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: "https://localhost:8080",
+		CACertPEM: "not a certificate",
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func TestTraceLoggingEnabled(t *testing.T) {
+	t.Setenv("TF_LOG", "debug")
+	t.Setenv("TF_LOG_PROVIDER", "")
+	assert.False(t, traceLoggingEnabled())
+
+	t.Setenv("TF_LOG_PROVIDER", "TRACE")
+	assert.True(t, traceLoggingEnabled())
+
+	t.Setenv("TF_LOG_PROVIDER", "")
+	t.Setenv("TF_LOG", "")
+	assert.False(t, traceLoggingEnabled())
+}
+
+func TestRedactLogBody(t *testing.T) {
+	assert.Equal(t, "", redactLogBody(nil))
+	assert.Equal(t, "<4 bytes>", redactLogBody([]byte("\x89PNG")))
+	assert.JSONEq(t,
+		`{"username": "admin", "password": "<redacted>", "results": [{"id": 1, "key": "<redacted>", "Token": "<redacted>"}]}`,
+		redactLogBody([]byte(`{"username": "admin", "password": "secret", "results": [{"id": 1, "key": "0123456789abcdef", "Token": "abc"}]}`)),
+	)
+}
+
+func TestLoggingTransportKeepsBodies(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	t.Setenv("TF_LOG", "TRACE")
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	res, err := client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "4.0.11", res.GetPayload().(map[string]interface{})["netbox-version"])
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:              "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:             ts.URL,
+		RequestTimeout:        10,
+		MaxConcurrentRequests: 2,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := status.NewStatusListParams()
+			_, err := client.Status.StatusList(req, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight, 2)
+}
+
+func TestRequestCache(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("hello"))
+	}))
+	defer ts.Close()
+
+	httpClient := &http.Client{
+		Transport: cachingTransport{
+			original: http.DefaultTransport,
+			cache:    &responseCache{entries: map[responseCacheKey]cachedResponse{}},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := httpClient.Get(ts.URL + "/api/dcim/sites/?name=foo")
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, "hello", string(body))
+	}
+	assert.Equal(t, 1, requests)
+
+	resp, err := httpClient.Post(ts.URL+"/api/dcim/sites/", "application/json", strings.NewReader("{}"))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, requests)
+
+	resp, err = httpClient.Get(ts.URL + "/api/dcim/sites/?name=foo")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 3, requests)
+
+	// Branches do not share cached responses, but their changes invalidate the
+	// responses of all branches
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/dcim/sites/?name=foo", nil)
+	req.Header.Set(branchHeader, "td5smq0f")
+	resp, err = httpClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 4, requests)
+
+	req, _ = http.NewRequest(http.MethodPatch, ts.URL+"/api/dcim/sites/1/", strings.NewReader("{}"))
+	req.Header.Set(branchHeader, "td5smq0f")
+	resp, err = httpClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 5, requests)
+
+	resp, err = httpClient.Get(ts.URL + "/api/dcim/sites/?name=foo")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 6, requests)
+}
+
+func TestUserAgentSet(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "terraform-provider-netbox/dev team-a", r.Header.Get("User-Agent"))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		UserAgent: "terraform-provider-netbox/dev team-a",
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+}
+
+type recordingTransport struct {
+	original http.RoundTripper
+	requests *[]*http.Request
+}
+
+func (t recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	*t.requests = append(*t.requests, r)
+	return t.original.RoundTrip(r)
+}
+
+func TestTransportWrapper(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	var requests []*http.Request
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+		MaxRetries:     1,
+		Headers:        map[string]interface{}{"X-Team": "network"},
+		TransportWrapper: func(original http.RoundTripper) http.RoundTripper {
+			return recordingTransport{original: original, requests: &requests}
+		},
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+
+	// The wrapper sees every attempt including all headers
+	assert.Len(t, requests, 2)
+	for _, r := range requests {
+		assert.Equal(t, "Token 07b12b765127747e4afd56cb531b7bf9c61f3c30", r.Header.Get("Authorization"))
+		assert.Equal(t, "network", r.Header.Get("X-Team"))
+	}
+}
+
+func TestSessionToken(t *testing.T) {
+	const key = "0123456789abcdef0123456789abcdef01234567"
+	provisioned, deleted := 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/tokens/provision/":
+			assert.Empty(t, r.Header.Get("Authorization"))
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "ci", body["username"])
+			assert.Equal(t, "secret", body["password"])
+			provisioned++
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 42, "key": "` + key + `"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/users/tokens/42/":
+			assert.Equal(t, "Token "+key, r.Header.Get("Authorization"))
+			assert.Empty(t, r.Header.Get(branchHeader))
+			deleted++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			assert.Equal(t, "Token "+key, r.Header.Get("Authorization"))
+			w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		Username:       "ci",
+		Password:       "secret",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+	_, err = client.Status.StatusList(status.NewStatusListParams(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, provisioned)
+
+	// The state of the provider owns the token and revokes it once
+	api := &providerState{NetBoxAPI: branchClient(client, "td5smq0f"), sessionToken: config.provisionedToken}
+	api.revokeSessionToken()
+	api.revokeSessionToken()
+	assert.Equal(t, 1, deleted)
+}
+
+func TestConnectionReuse(t *testing.T) {
+	for _, disableKeepAlives := range []bool{false, true} {
+		var mu sync.Mutex
+		connections := 0
+		ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+		}))
+		ts.Config.ConnState = func(c net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				mu.Lock()
+				connections++
+				mu.Unlock()
+			}
+		}
+		ts.StartTLS()
+
+		config := Config{
+			APIToken:           "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			ServerURL:          ts.URL,
+			AllowInsecureHTTPS: true,
+			RequestTimeout:     10,
+			MaxIdleConns:       10,
+			IdleConnTimeout:    90,
+			DisableKeepAlives:  disableKeepAlives,
+		}
+
+		client, err := config.Client()
+		assert.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			_, err = client.Status.StatusList(status.NewStatusListParams(), nil)
+			assert.NoError(t, err)
+		}
+		ts.Close()
+
+		expected := 1
+		if disableKeepAlives {
+			expected = 5
+		}
+		assert.Equal(t, expected, connections, "disable_keepalives = %t", disableKeepAlives)
+	}
+}
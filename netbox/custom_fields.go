@@ -1,6 +1,14 @@
 package netbox
 
 import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,10 +24,210 @@ var customFieldsSchema = &schema.Schema{
 	},
 }
 
-func getCustomFields(cf interface{}) map[string]interface{} {
+var customFieldsSchemaRead = &schema.Schema{
+	Type:     schema.TypeMap,
+	Computed: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+}
+
+// wrapUnmanagedCustomFields makes the create, read and update functions of
+// the given resource keep only the custom fields in the state that were
+// declared before, if the provider ignores unmanaged custom fields. It must be
+// called after wrapAPIErrors.
+func wrapUnmanagedCustomFields(r *schema.Resource) {
+	if _, ok := r.Schema[customFieldsKey]; !ok {
+		return
+	}
+	r.CreateContext = withManagedCustomFields(r.CreateContext)
+	r.ReadContext = withManagedCustomFields(r.ReadContext)
+	r.UpdateContext = withManagedCustomFields(r.UpdateContext)
+}
+
+func withManagedCustomFields(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		managed, _ := d.Get(customFieldsKey).(map[string]interface{})
+
+		diags := f(ctx, d, m)
+
+		api, ok := m.(*providerState)
+		if !ok || !api.ignoreUnmanagedCustomFields || d.Id() == "" {
+			return diags
+		}
+		cf, _ := d.Get(customFieldsKey).(map[string]interface{})
+		for name := range cf {
+			if _, ok := managed[name]; !ok {
+				delete(cf, name)
+			}
+		}
+		d.Set(customFieldsKey, cf)
+		return diags
+	}
+}
+
+// getCustomFieldTypes returns the types of all custom fields in Netbox by
+// field name.
+func getCustomFieldTypes(api *providerState) (map[string]string, error) {
+	limit := int64(0)
+	params := extras.NewExtrasCustomFieldsListParams()
+	params.Limit = &limit
+
+	res, err := api.Extras.ExtrasCustomFieldsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]string)
+	for _, field := range res.GetPayload().Results {
+		if field.Name != nil && field.Type != nil && field.Type.Value != nil {
+			types[*field.Name] = *field.Type.Value
+		}
+	}
+	return types, nil
+}
+
+// getCustomFields returns the custom fields of a Netbox object as the string
+// values of the custom_fields attribute.
+func getCustomFields(api *providerState, cf interface{}) map[string]interface{} {
 	cfm, ok := cf.(map[string]interface{})
 	if !ok || len(cfm) == 0 {
 		return nil
 	}
-	return cfm
+
+	// Only the values of object fields cannot be told apart from JSON
+	// values without the type of the field.
+	var types map[string]string
+	for _, value := range cfm {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			types, _ = getCustomFieldTypes(api)
+		default:
+			continue
+		}
+		break
+	}
+
+	result := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		result[name] = customFieldValueToString(types[name], value)
+	}
+	return result
+}
+
+func customFieldValueToString(fieldType string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil, string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueObject:
+		if id, ok := customFieldObjectID(value); ok {
+			return id
+		}
+	case models.CustomFieldTypeValueMultiobject:
+		if objects, ok := value.([]interface{}); ok {
+			ids := make([]json.Number, 0, len(objects))
+			for _, object := range objects {
+				id, ok := customFieldObjectID(object)
+				if !ok {
+					break
+				}
+				ids = append(ids, json.Number(id))
+			}
+			if len(ids) == len(objects) {
+				idsJSON, _ := json.Marshal(ids)
+				return string(idsJSON)
+			}
+		}
+	}
+
+	valueJSON, _ := json.Marshal(value)
+	return string(valueJSON)
+}
+
+func customFieldObjectID(object interface{}) (string, bool) {
+	o, ok := object.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	switch id := o["id"].(type) {
+	case json.Number:
+		return id.String(), true
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// getCustomFieldsForAPI converts the string values of the custom_fields
+// attribute to the types of the custom fields in Netbox. Values that do not
+// match the type of their field are sent unchanged, so that Netbox can reject
+// them with a meaningful error.
+func getCustomFieldsForAPI(api *providerState, cf interface{}) interface{} {
+	cfm, ok := cf.(map[string]interface{})
+	if !ok || len(cfm) == 0 {
+		return cf
+	}
+
+	types, err := getCustomFieldTypes(api)
+	if err != nil {
+		return cf
+	}
+
+	result := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		s, ok := value.(string)
+		if !ok {
+			result[name] = value
+			continue
+		}
+		result[name] = customFieldValueFromString(types[name], s)
+	}
+	return result
+}
+
+func customFieldValueFromString(fieldType string, value string) interface{} {
+	switch fieldType {
+	case "", models.CustomFieldTypeValueText, models.CustomFieldTypeValueLongtext, models.CustomFieldTypeValueURL, models.CustomFieldTypeValueSelect:
+		return value
+	}
+
+	// An empty value clears fields of all other types
+	if value == "" {
+		return nil
+	}
+
+	switch fieldType {
+	case models.CustomFieldTypeValueBoolean:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case models.CustomFieldTypeValueInteger, models.CustomFieldTypeValueObject:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	case models.CustomFieldTypeValueDecimal:
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case models.CustomFieldTypeValueJSON, models.CustomFieldTypeValueMultiselect, models.CustomFieldTypeValueMultiobject:
+		dec := json.NewDecoder(strings.NewReader(value))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err == nil && !dec.More() {
+			return v
+		}
+	}
+	return value
 }
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldValueFromString(t *testing.T) {
+	for _, tc := range []struct {
+		fieldType string
+		value     string
+		expected  interface{}
+	}{
+		{models.CustomFieldTypeValueText, "81", "81"},
+		{models.CustomFieldTypeValueText, "", ""},
+		{"", "unknown field", "unknown field"},
+		{models.CustomFieldTypeValueBoolean, "true", true},
+		{models.CustomFieldTypeValueBoolean, "false", false},
+		{models.CustomFieldTypeValueBoolean, "", nil},
+		{models.CustomFieldTypeValueInteger, "81", int64(81)},
+		{models.CustomFieldTypeValueInteger, "eighty-one", "eighty-one"},
+		{models.CustomFieldTypeValueDecimal, "1.50", json.Number("1.50")},
+		{models.CustomFieldTypeValueDate, "2024-06-01", "2024-06-01"},
+		{models.CustomFieldTypeValueDate, "", nil},
+		{models.CustomFieldTypeValueObject, "7", int64(7)},
+		{models.CustomFieldTypeValueMultiobject, "[7,8]", []interface{}{json.Number("7"), json.Number("8")}},
+		{models.CustomFieldTypeValueMultiselect, `["a","b"]`, []interface{}{"a", "b"}},
+		{models.CustomFieldTypeValueJSON, `{"owner":"ops"}`, map[string]interface{}{"owner": "ops"}},
+		{models.CustomFieldTypeValueJSON, `{"owner":`, `{"owner":`},
+	} {
+		assert.Equal(t, tc.expected, customFieldValueFromString(tc.fieldType, tc.value), "%s %q", tc.fieldType, tc.value)
+	}
+}
+
+func TestCustomFieldValueToString(t *testing.T) {
+	object := map[string]interface{}{"id": json.Number("7"), "url": "http://netbox/api/dcim/sites/7/", "display": "site"}
+
+	for _, tc := range []struct {
+		fieldType string
+		value     interface{}
+		expected  interface{}
+	}{
+		{models.CustomFieldTypeValueText, "81", "81"},
+		{models.CustomFieldTypeValueText, nil, nil},
+		{models.CustomFieldTypeValueBoolean, true, "true"},
+		{models.CustomFieldTypeValueInteger, json.Number("81"), "81"},
+		{models.CustomFieldTypeValueDecimal, json.Number("1.5"), "1.5"},
+		{models.CustomFieldTypeValueObject, object, "7"},
+		{models.CustomFieldTypeValueMultiobject, []interface{}{object}, "[7]"},
+		{models.CustomFieldTypeValueMultiselect, []interface{}{"a", "b"}, `["a","b"]`},
+		{models.CustomFieldTypeValueJSON, map[string]interface{}{"owner": "ops", "cost": json.Number("3")}, `{"cost":3,"owner":"ops"}`},
+	} {
+		assert.Equal(t, tc.expected, customFieldValueToString(tc.fieldType, tc.value), "%s %v", tc.fieldType, tc.value)
+	}
+}
+
+func TestWrapUnmanagedCustomFields(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			customFieldsKey: customFieldsSchema,
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return d.Set(customFieldsKey, map[string]interface{}{"owner": "ops", "cost_center": "42"})
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapUnmanagedCustomFields(r)
+
+	for _, ignore := range []bool{false, true} {
+		api := &providerState{NetBoxAPI: &client.NetBoxAPI{}, ignoreUnmanagedCustomFields: ignore}
+
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			customFieldsKey: map[string]interface{}{"owner": "noc"},
+		})
+		d.SetId("1")
+		assert.Nil(t, r.ReadContext(context.Background(), d, api))
+
+		if ignore {
+			assert.Equal(t, map[string]interface{}{"owner": "ops"}, d.Get(customFieldsKey))
+		} else {
+			assert.Equal(t, map[string]interface{}{"owner": "ops", "cost_center": "42"}, d.Get(customFieldsKey))
+		}
+	}
+}
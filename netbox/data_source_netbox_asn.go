@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxAsn() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxAsnRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -40,13 +40,14 @@ for more information on available lookup expressions.`,
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchemaRead,
-		},
+			"tags":          tagsSchemaRead,
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamAsnsListParams()
 
@@ -69,11 +70,8 @@ func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one asn returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no asn found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "asn"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
@@ -81,5 +79,12 @@ func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", result.Description)
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
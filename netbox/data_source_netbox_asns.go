@@ -2,10 +2,10 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,8 +22,9 @@ func dataSourceNetboxAsns() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -37,12 +38,19 @@ func dataSourceNetboxAsns() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"asns": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -56,7 +64,7 @@ func dataSourceNetboxAsns() *schema.Resource {
 							Computed: true,
 						},
 						"tags": tagsSchemaRead,
-					},
+					}),
 				},
 			},
 		},
@@ -64,14 +72,11 @@ func dataSourceNetboxAsns() *schema.Resource {
 }
 
 func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamAsnsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -88,27 +93,54 @@ func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
 			case "asn__n":
 				params.Asnn = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Ipam.IpamAsnsList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.ASN, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Ipam.IpamAsnsList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredAsns := res.GetPayload().Results
+	filteredAsns := results
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("asns", getBriefMappings(filteredAsns, map[string]string{
+			"id":  "ID",
+			"asn": "Asn",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredAsns {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["id"] = v.ID
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		mapping["asn"] = v.Asn
 		mapping["rir_id"] = v.Rir.ID
 		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
@@ -0,0 +1,237 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cableTraceObjectTypes are the API paths of the objects a cable trace can
+// start at, by content type.
+var cableTraceObjectTypes = map[string]string{
+	"circuits.circuittermination": "/circuits/circuit-terminations/",
+	"dcim.consoleport":            "/dcim/console-ports/",
+	"dcim.consoleserverport":      "/dcim/console-server-ports/",
+	"dcim.interface":              "/dcim/interfaces/",
+	"dcim.powerfeed":              "/dcim/power-feeds/",
+	"dcim.poweroutlet":            "/dcim/power-outlets/",
+	"dcim.powerport":              "/dcim/power-ports/",
+}
+
+// cableTraceTerminationTypes are the content types of the objects that can
+// appear in a cable trace, by API path.
+var cableTraceTerminationTypes = map[string]string{
+	"/circuits/circuit-terminations/": "circuits.circuittermination",
+	"/circuits/provider-networks/":    "circuits.providernetwork",
+	"/dcim/console-ports/":            "dcim.consoleport",
+	"/dcim/console-server-ports/":     "dcim.consoleserverport",
+	"/dcim/front-ports/":              "dcim.frontport",
+	"/dcim/interfaces/":               "dcim.interface",
+	"/dcim/power-feeds/":              "dcim.powerfeed",
+	"/dcim/power-outlets/":            "dcim.poweroutlet",
+	"/dcim/power-ports/":              "dcim.powerport",
+	"/dcim/rear-ports/":               "dcim.rearport",
+}
+
+var cableTraceTerminationSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The device of the termination, if it belongs to one.",
+			},
+		},
+	},
+}
+
+func dataSourceNetboxCableTrace() *schema.Resource {
+	var objectTypes []string
+	for objectType := range cableTraceObjectTypes {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	return &schema.Resource{
+		Read:        dataSourceNetboxCableTraceRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Traces the cable path from an interface, port, power feed or circuit termination to its far end, like the trace view of Netbox. The path consists of segments, each of which connects the terminations of its near end through a cable to the terminations of its far end, e.g. the front port of a patch panel. The far end of the last segment is the endpoint of the path.`,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(objectTypes, false),
+				Description:  "The type of the object the trace starts at. " + buildValidValueDescription(objectTypes),
+			},
+			"object_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the object the trace starts at.",
+			},
+			"segment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"near_end": cableTraceTerminationSchema,
+						"cable_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The cable of the segment, or `0` if the near end is not connected.",
+						},
+						"cable_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"far_end": cableTraceTerminationSchema,
+					},
+				},
+				Description: "The segments of the path in order.",
+			},
+			"far_end": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        cableTraceTerminationSchema.Elem,
+				Description: "The terminations at the end of the path. Empty if the path is not complete.",
+			},
+		},
+	}
+}
+
+// getCableTraceTerminations returns the terminations of a segment end of a
+// cable trace. Older Netbox versions return a single object instead of a
+// list.
+func getCableTraceTerminations(value interface{}) []map[string]interface{} {
+	var objects []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		objects = v
+	case map[string]interface{}:
+		objects = []interface{}{v}
+	}
+
+	terminations := []map[string]interface{}{}
+	for _, object := range objects {
+		termination, ok := object.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// The type is not part of the response, so it is derived from the
+		// endpoint of the object, e.g. /dcim/front-ports/ of
+		// /dcim/front-ports/5/.
+		objectType := ""
+		if objectURL, ok := termination["url"].(string); ok {
+			if path, err := apiPathFromURL(objectURL); err == nil {
+				if parts := strings.Split(strings.Trim(path, "/"), "/"); len(parts) > 1 {
+					objectType = cableTraceTerminationTypes["/"+parts[0]+"/"+parts[1]+"/"]
+				}
+			}
+		}
+		objectID, _ := termination["id"].(json.Number)
+		var deviceID json.Number
+		if device, ok := termination["device"].(map[string]interface{}); ok {
+			deviceID, _ = device["id"].(json.Number)
+		}
+		name, _ := termination["name"].(string)
+		display, _ := termination["display"].(string)
+
+		terminations = append(terminations, map[string]interface{}{
+			"object_type": objectType,
+			"object_id":   jsonNumberToInt(objectID),
+			"name":        name,
+			"display":     display,
+			"device_id":   jsonNumberToInt(deviceID),
+		})
+	}
+	return terminations
+}
+
+// getCableTraceSegments returns the segments of the cable trace response of
+// Netbox, which is a list of [near end, cable, far end] tuples.
+func getCableTraceSegments(res interface{}) ([]map[string]interface{}, error) {
+	trace, ok := res.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected cable trace response: %v", res)
+	}
+
+	segments := []map[string]interface{}{}
+	for _, element := range trace {
+		tuple, ok := element.([]interface{})
+		if !ok || len(tuple) != 3 {
+			return nil, fmt.Errorf("unexpected segment in cable trace response: %v", element)
+		}
+
+		var cableID json.Number
+		var cableLabel string
+		if cable, ok := tuple[1].(map[string]interface{}); ok {
+			cableID, _ = cable["id"].(json.Number)
+			cableLabel, _ = cable["label"].(string)
+		}
+
+		segments = append(segments, map[string]interface{}{
+			"near_end":    getCableTraceTerminations(tuple[0]),
+			"cable_id":    jsonNumberToInt(cableID),
+			"cable_label": cableLabel,
+			"far_end":     getCableTraceTerminations(tuple[2]),
+		})
+	}
+	return segments, nil
+}
+
+// jsonNumberToInt returns the integer value of the given number, or 0 if it
+// is empty.
+func jsonNumberToInt(n json.Number) int {
+	i, _ := n.Int64()
+	return int(i)
+}
+
+func dataSourceNetboxCableTraceRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	objectType := d.Get("object_type").(string)
+	objectID := strconv.Itoa(d.Get("object_id").(int))
+
+	res, err := apiRequest(api, http.MethodGet, cableTraceObjectTypes[objectType]+objectID+"/trace/", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	segments, err := getCableTraceSegments(res)
+	if err != nil {
+		return err
+	}
+
+	farEnd := []map[string]interface{}{}
+	if len(segments) > 0 {
+		farEnd = segments[len(segments)-1]["far_end"].([]map[string]interface{})
+	}
+
+	d.SetId(objectType + "/" + objectID)
+	d.Set("segment", segments)
+	d.Set("far_end", farEnd)
+	return nil
+}
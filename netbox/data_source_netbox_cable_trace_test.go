@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxCableTraceDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("cable_trace_ds_basic")
+	setUp := fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "panel" {
+  name = "%[1]s-panel"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "a" {
+  name = "eth0"
+  type = "1000base-t"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_device_interface" "b" {
+  name = "eth1"
+  type = "1000base-t"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_device_rear_port" "test" {
+  device_id = netbox_device.panel.id
+  name = "rear1"
+  type = "8p8c"
+  positions = 1
+}
+
+resource "netbox_device_front_port" "test" {
+  device_id = netbox_device.panel.id
+  name = "front1"
+  type = "8p8c"
+  rear_port_id = netbox_device_rear_port.test.id
+  rear_port_position = 1
+}
+
+resource "netbox_cable" "a" {
+  label = "%[1]s-a"
+  status = "connected"
+  a_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.a.id
+  }
+  b_termination {
+    object_type = "dcim.frontport"
+    object_id = netbox_device_front_port.test.id
+  }
+}
+
+resource "netbox_cable" "b" {
+  label = "%[1]s-b"
+  status = "connected"
+  a_termination {
+    object_type = "dcim.rearport"
+    object_id = netbox_device_rear_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.b.id
+  }
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + `
+data "netbox_cable_trace" "test" {
+  object_type = "dcim.interface"
+  object_id = netbox_device_interface.a.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "segment.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segment.0.near_end.0.object_id", "netbox_device_interface.a", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segment.0.cable_id", "netbox_cable.a", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "segment.0.cable_label", testName+"-a"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "segment.0.far_end.0.object_type", "dcim.frontport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segment.0.far_end.0.device_id", "netbox_device.panel", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "segment.1.near_end.0.object_type", "dcim.rearport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "segment.1.cable_id", "netbox_cable.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.0.object_type", "dcim.interface"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.test", "far_end.0.object_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.test", "far_end.0.name", "eth1"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetCableTraceSegments(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/interfaces/1/trace/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+  [
+    [{"id": 1, "url": "http://netbox/api/dcim/interfaces/1/", "display": "eth0", "name": "eth0", "device": {"id": 10}}],
+    {"id": 100, "label": "uplink"},
+    [{"id": 2, "url": "http://netbox/api/dcim/front-ports/2/", "display": "front1", "name": "front1", "device": {"id": 20}}]
+  ],
+  [
+    [{"id": 3, "url": "http://netbox/api/dcim/rear-ports/3/", "display": "rear1", "name": "rear1", "device": {"id": 20}}],
+    null,
+    null
+  ]
+]`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	res, err := apiRequest(api, http.MethodGet, "/dcim/interfaces/1/trace/", nil, nil)
+	assert.NoError(t, err)
+
+	segments, err := getCableTraceSegments(res)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"near_end": []map[string]interface{}{
+				{"object_type": "dcim.interface", "object_id": 1, "name": "eth0", "display": "eth0", "device_id": 10},
+			},
+			"cable_id":    100,
+			"cable_label": "uplink",
+			"far_end": []map[string]interface{}{
+				{"object_type": "dcim.frontport", "object_id": 2, "name": "front1", "display": "front1", "device_id": 20},
+			},
+		},
+		{
+			"near_end": []map[string]interface{}{
+				{"object_type": "dcim.rearport", "object_id": 3, "name": "rear1", "display": "rear1", "device_id": 20},
+			},
+			"cable_id":    0,
+			"cable_label": "",
+			"far_end":     []map[string]interface{}{},
+		},
+	}, segments)
+
+	_, err = getCableTraceSegments(map[string]interface{}{})
+	assert.Error(t, err)
+}
@@ -1,11 +1,9 @@
 package netbox
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,7 +12,9 @@ func dataSourceNetboxCluster() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxClusterRead,
 		Description: `:meta:subcategory:Virtualization:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"cluster_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -39,6 +39,7 @@ func dataSourceNetboxCluster() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"name", "site_id", "id"},
 			},
+			"site": relatedObjectSchema,
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -48,22 +49,24 @@ func dataSourceNetboxCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"cluster_type": relatedObjectSchema,
 			"cluster_group_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Optional: true,
 			},
+			"cluster_group": relatedObjectSchema,
 			"custom_fields": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
 			tagsKey: tagsSchemaRead,
-		},
+		}),
 	}
 }
 
 func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := virtualization.NewVirtualizationClustersListParams()
 
@@ -92,23 +95,22 @@ func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one result, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "cluster"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	d.Set("name", result.Name)
 	d.Set("cluster_type_id", result.Type.ID)
+	d.Set("cluster_type", getRelatedObject(result.Type))
 
 	if result.Group != nil {
 		d.Set("cluster_group_id", result.Group.ID)
 	} else {
 		d.Set("cluster_group_id", nil)
 	}
+	d.Set("cluster_group", getRelatedObject(result.Group))
 	d.Set("comments", result.Comments)
 	d.Set("description", result.Description)
 	if result.Site != nil {
@@ -116,10 +118,13 @@ func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(result.Site))
 	if result.CustomFields != nil {
-		d.Set("custom_fields", result.CustomFields)
+		d.Set("custom_fields", getCustomFields(api, result.CustomFields))
 	}
 
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxClusterGroup() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxClusterGroupRead,
 		Description: `:meta:subcategory:Virtualization:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"cluster_group_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -22,12 +22,13 @@ func dataSourceNetboxClusterGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := virtualization.NewVirtualizationClusterGroupsListParams()
@@ -40,15 +41,19 @@ func dataSourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) err
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one cluster group returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no cluster group found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "cluster group"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_group_id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	d.Set("name", result.Name)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxClusterType() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxClusterTypeRead,
 		Description: `:meta:subcategory:Virtualization:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"cluster_type_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -22,12 +22,13 @@ func dataSourceNetboxClusterType() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := virtualization.NewVirtualizationClusterTypesListParams()
@@ -40,15 +41,19 @@ func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one cluster type returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no cluster type found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "cluster type"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_type_id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	d.Set("name", result.Name)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
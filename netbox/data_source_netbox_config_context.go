@@ -2,10 +2,8 @@ package netbox
 
 import (
 	"encoding/json"
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,7 +12,9 @@ func dataSourceNetboxConfigContext() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxConfigContextRead,
 		Description: `:meta:subcategory:Extras:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -135,12 +135,12 @@ func dataSourceNetboxConfigContext() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := extras.NewExtrasConfigContextsListParams()
@@ -153,11 +153,8 @@ func dataSourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) er
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one result. Specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "config context"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -250,5 +247,6 @@ func dataSourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) er
 	}
 	d.Set("tags", tags)
 
+	setObjectMetadata(d, result)
 	return nil
 }
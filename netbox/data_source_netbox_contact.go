@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxContact() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxContactRead,
 		Description: `:meta:subcategory:Tenancy:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -34,12 +34,13 @@ func dataSourceNetboxContact() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := tenancy.NewTenancyContactsListParams()
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -54,11 +55,8 @@ func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one contact returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no contact found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "contact"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -66,5 +64,12 @@ func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 	if result.Group != nil {
 		d.Set("group_id", result.Group.ID)
 	}
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxContactRole() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxContactRoleRead,
 		Description: `:meta:subcategory:Tenancy:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -26,12 +26,13 @@ func dataSourceNetboxContactRole() *schema.Resource {
 				Computed:     true,
 				AtLeastOneOf: []string{"name", "slug"},
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := tenancy.NewTenancyContactRolesListParams()
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -46,15 +47,19 @@ func dataSourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one contact role returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no contact role found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "contact role"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
 	d.Set("name", result.Name)
 	d.Set("slug", result.Slug)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
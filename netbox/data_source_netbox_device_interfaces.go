@@ -2,10 +2,8 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
-	"regexp"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -24,8 +22,9 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -34,16 +33,24 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 					},
 				},
 			},
-			"name_regex": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsValidRegExp,
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"order_by":            listOrderBySchema,
+			"name_regex":          listNameRegexSchema,
 			"interfaces": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -128,7 +135,7 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -136,10 +143,11 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 }
 
 func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimInterfacesListParams()
 
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -148,44 +156,62 @@ func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{})
 			vString := v.(string)
 			switch k {
 			case "mac_address":
-				params.MacAddress = &vString
+				params.MacAddress = strToPtr(normalizeMACAddress(vString))
 			case "name":
 				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
 			case "device_id":
 				params.DeviceID = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Dcim.DcimInterfacesList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Interface, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Dcim.DcimInterfacesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	var filteredInterfaces []*models.Interface
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
-		for _, dcimInterface := range res.GetPayload().Results {
-			if r.MatchString(*dcimInterface.Name) {
-				filteredInterfaces = append(filteredInterfaces, dcimInterface)
-			}
-		}
-	} else {
-		filteredInterfaces = res.GetPayload().Results
+	filteredInterfaces := filterByRegex(d, "name_regex", results, func(dcimInterface *models.Interface) string {
+		return *dcimInterface.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("interfaces", getBriefMappings(filteredInterfaces, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"description": "Description",
+		}))
 	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredInterfaces {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 		mapping["id"] = v.ID
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		if v.Description != "" {
 			mapping["description"] = v.Description
 		}
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxDeviceRole() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxDeviceRoleRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -26,13 +26,14 @@ func dataSourceNetboxDeviceRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchemaRead,
-		},
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := dcim.NewDcimDeviceRolesListParams()
@@ -45,11 +46,8 @@ func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one device role returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no device role found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "device role"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -57,5 +55,12 @@ func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error
 	d.Set("slug", result.Slug)
 	d.Set("color_hex", result.Color)
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
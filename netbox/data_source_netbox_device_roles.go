@@ -0,0 +1,178 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxDeviceRoles() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDeviceRolesRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of filter to apply to the API query when requesting device roles.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `parent_id`, `vm_role` or `cf_<custom field>`. All filters of the Netbox API are supported.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value to pass to the specified filter.",
+						},
+					},
+				},
+			},
+			"name_regex": listNameRegexSchema,
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
+			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
+			"device_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"color_hex": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vm_role": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"depth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"config_template_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						tagsKey: tagsSchemaRead,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDeviceRolesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// go-netbox does not know the parent and config template of device roles
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		for _, f := range filter.(*schema.Set).List() {
+			k := f.(map[string]interface{})["name"]
+			v := f.(map[string]interface{})["value"]
+			query.Add(k.(string), v.(string))
+		}
+	}
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+	if ordering := getListOrdering(d); ordering != nil {
+		query.Set("ordering", *ordering)
+	}
+
+	results, err := apiList(api, "/dcim/device-roles/", query, d.Get("limit").(int), d.Get("offset").(int))
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	var deviceRoles []*deviceRole
+	if err := json.Unmarshal(payload, &deviceRoles); err != nil {
+		return err
+	}
+
+	filteredDeviceRoles := filterByRegex(d, "name_regex", deviceRoles, func(v *deviceRole) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("device_roles", getBriefMappings(filteredDeviceRoles, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"slug":        "Slug",
+			"description": "Description",
+		}))
+	}
+
+	var s []map[string]any
+	for _, v := range filteredDeviceRoles {
+		var mapping = make(map[string]any)
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
+
+		mapping["id"] = strconv.FormatInt(v.ID, 10)
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		mapping["description"] = v.Description
+		mapping["color_hex"] = v.Color
+		mapping["vm_role"] = v.VMRole
+		mapping["depth"] = v.Depth
+		mapping[tagsKey] = getTagListFromNestedTagList(v.Tags)
+
+		if v.Parent != nil {
+			mapping["parent_id"] = v.Parent.ID
+		}
+
+		if v.ConfigTemplate != nil {
+			mapping["config_template_id"] = v.ConfigTemplate.ID
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("device_roles", s)
+}
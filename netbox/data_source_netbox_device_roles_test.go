@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceRolesDataSource_basic(t *testing.T) {
+	testSlug := "dvcrls_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_device_role" "test" {
+  name               = "%[1]s"
+  color_hex          = "111111"
+  vm_role            = false
+  config_template_id = netbox_config_template.test.id
+}
+
+resource "netbox_device_role" "other" {
+  name      = "%[1]s_other"
+  color_hex = "222222"
+}
+
+data "netbox_device_roles" "by_slug" {
+  filter {
+    name  = "slug"
+    value = netbox_device_role.test.slug
+  }
+}
+
+data "netbox_device_roles" "by_name_regex" {
+  name_regex = "%[1]s"
+  depends_on = [netbox_device_role.test, netbox_device_role.other]
+}
+
+data "netbox_device_roles" "no_match" {
+  filter {
+    name  = "name"
+    value = "non-existent"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_device_roles.by_slug", "device_roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_roles.by_slug", "device_roles.0.id", "netbox_device_role.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_roles.by_slug", "device_roles.0.name", testName),
+					resource.TestCheckResourceAttr("data.netbox_device_roles.by_slug", "device_roles.0.color_hex", "111111"),
+					resource.TestCheckResourceAttr("data.netbox_device_roles.by_slug", "device_roles.0.vm_role", "false"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_roles.by_slug", "device_roles.0.config_template_id", "netbox_config_template.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_roles.by_name_regex", "device_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_device_roles.no_match", "device_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
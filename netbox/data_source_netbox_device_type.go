@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxDeviceType() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxDeviceTypeRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"is_full_depth": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -42,12 +42,13 @@ func dataSourceNetboxDeviceType() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimDeviceTypesListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -69,11 +70,8 @@ func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one device type returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no device type found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "device type"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -83,5 +81,12 @@ func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error
 	d.Set("part_number", result.PartNumber)
 	d.Set("slug", result.Slug)
 	d.Set("u_height", result.UHeight)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
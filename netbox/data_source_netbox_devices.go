@@ -5,16 +5,13 @@ package netbox
 
 import (
 	"encoding/json"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net"
-	"regexp"
 	"strings"
 )
 
@@ -29,8 +26,9 @@ func dataSourceNetboxDevices() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -39,20 +37,22 @@ func dataSourceNetboxDevices() *schema.Resource {
 					},
 				},
 			},
-			"name_regex": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsValidRegExp,
-			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"devices": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"asset_tag": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -150,7 +150,7 @@ func dataSourceNetboxDevices() *schema.Resource {
 							Computed: true,
 						},
 						"tags": tagsSchemaRead,
-					},
+					}),
 				},
 			},
 		},
@@ -158,10 +158,11 @@ func dataSourceNetboxDevices() *schema.Resource {
 }
 
 func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimDevicesListParams()
 
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -199,42 +200,60 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 				var tenantIDString = v.(string)
 				params.TenantID = &tenantIDString
 			case "tags":
-				var tagsString = v.(string)
-				params.Tag = strings.Split(tagsString, ",")
+				for _, tag := range strings.Split(v.(string), ",") {
+					query.Add("tag", tag)
+				}
 			case "status":
 				var statusString = v.(string)
 				params.Status = &statusString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
 	}
 
-	res, err := api.Dcim.DcimDevicesList(params, nil)
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.DeviceWithConfigContext, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Dcim.DcimDevicesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	var filteredDevices []*models.DeviceWithConfigContext
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
-		for _, device := range res.GetPayload().Results {
-			if r.MatchString(*device.Name) {
-				filteredDevices = append(filteredDevices, device)
-			}
+	filteredDevices := filterByRegex(d, "name_regex", results, func(device *models.DeviceWithConfigContext) string {
+		if device.Name == nil {
+			return ""
 		}
-	} else {
-		filteredDevices = res.GetPayload().Results
+		return *device.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("devices", getBriefMappings(filteredDevices, map[string]string{
+			"device_id":   "ID",
+			"name":        "Name",
+			"description": "Description",
+		}))
 	}
 
 	var s []map[string]interface{}
 	for _, device := range filteredDevices {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(device) {
+			mapping[key] = value
+		}
 		if device.AssetTag != nil {
 			mapping["asset_tag"] = *device.AssetTag
 		}
@@ -292,7 +311,7 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 			mapping["status"] = *device.Status.Value
 		}
 		if device.CustomFields != nil {
-			mapping["custom_fields"] = device.CustomFields
+			mapping["custom_fields"] = getCustomFields(api, device.CustomFields)
 		}
 		if device.Rack != nil {
 			mapping["rack_id"] = device.Rack.ID
@@ -0,0 +1,73 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxGraphQL() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxGraphQLRead,
+		Description: `:meta:subcategory:Extras:Executes a query against the [GraphQL API](https://docs.netbox.dev/en/stable/integrations/graphql-api/) of Netbox and returns the result as JSON. Use ` + "`jsondecode()`" + ` to access the result.`,
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GraphQL query to execute.",
+			},
+			"variables": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "JSON encoded object with the variables used in `query`.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON encoded `data` of the GraphQL response.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxGraphQLRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	body := map[string]interface{}{
+		"query": d.Get("query").(string),
+	}
+	if variables, ok := d.GetOk("variables"); ok {
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(variables.(string)), &v); err != nil {
+			return err
+		}
+		body["variables"] = v
+	}
+
+	// The GraphQL endpoint lives next to the REST API, not below it
+	res, err := apiRequest(api, "POST", "/../graphql/", nil, body)
+	if err != nil {
+		return err
+	}
+
+	payload, ok := res.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected GraphQL response: %v", res)
+	}
+	if errs, ok := payload["errors"]; ok && errs != nil {
+		errJSON, _ := json.Marshal(errs)
+		return fmt.Errorf("GraphQL query failed: %s", errJSON)
+	}
+
+	result, err := json.Marshal(payload["data"])
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("result", string(result))
+}
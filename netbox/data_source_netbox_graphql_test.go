@@ -0,0 +1,34 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxGraphQLDataSource_basic(t *testing.T) {
+	testSlug := "graphql_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+data "netbox_graphql" "test" {
+  depends_on = [netbox_tag.test]
+  query      = "query ($name: [String!]) { tag_list(filters: {name: $name}) { name } }"
+  variables  = jsonencode({ name = ["%[1]s"] })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_graphql.test", "result", fmt.Sprintf(`{"tag_list":[{"name":"%s"}]}`, testName)),
+				),
+			},
+		},
+	})
+}
@@ -2,10 +2,8 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
-	"regexp"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -24,8 +22,9 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -39,18 +38,19 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
-				Description:      "The limit of objects to return from the API lookup.",
-			},
-			"name_regex": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsValidRegExp,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"order_by":            listOrderBySchema,
+			"name_regex":          listNameRegexSchema,
 			"interfaces": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -135,7 +135,7 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -143,12 +143,11 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 }
 
 func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := virtualization.NewVirtualizationInterfacesListParams()
 
-	params.Limit = getOptionalInt(d, "limit")
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -159,44 +158,62 @@ func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error
 			case "cluster_id":
 				params.ClusterID = &vString
 			case "mac_address":
-				params.MacAddress = &vString
+				params.MacAddress = strToPtr(normalizeMACAddress(vString))
 			case "name":
 				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
 			case "vm_id":
 				params.VirtualMachineID = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Virtualization.VirtualizationInterfacesList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.VMInterface, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Virtualization.VirtualizationInterfacesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	var filteredInterfaces []*models.VMInterface
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
-		for _, vmInterface := range res.GetPayload().Results {
-			if r.MatchString(*vmInterface.Name) {
-				filteredInterfaces = append(filteredInterfaces, vmInterface)
-			}
-		}
-	} else {
-		filteredInterfaces = res.GetPayload().Results
+	filteredInterfaces := filterByRegex(d, "name_regex", results, func(vmInterface *models.VMInterface) string {
+		return *vmInterface.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("interfaces", getBriefMappings(filteredInterfaces, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"description": "Description",
+		}))
 	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredInterfaces {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 		mapping["id"] = v.ID
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		if v.Description != "" {
 			mapping["description"] = v.Description
 		}
@@ -2,9 +2,8 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -23,8 +22,9 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -33,17 +33,29 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 					},
 				},
 			},
+			"dns_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression that the DNS names of the IP addresses have to match. It is applied to the IP addresses returned by Netbox, after `limit` and `offset`.",
+			},
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"ip_addresses": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -52,14 +64,6 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"created": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"last_updated": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
 						"custom_fields": {
 							Type:     schema.TypeMap,
 							Computed: true,
@@ -129,7 +133,7 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 								},
 							},
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -137,17 +141,13 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 }
 
 func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamIPAddressesListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
-		var tags []string
 		for _, f := range filterParams.List() {
 			k := f.(map[string]interface{})["name"]
 			v := f.(map[string]interface{})["value"]
@@ -173,25 +173,48 @@ func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) erro
 				params.Tenant = &vString
 			case "parent_prefix":
 				params.Parent = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Ipam.IpamIPAddressesList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.IPAddress, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Ipam.IpamIPAddressesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredIPAddresses := res.GetPayload().Results
+	filteredIPAddresses := filterByRegex(d, "dns_name_regex", results, func(v *models.IPAddress) string {
+		return v.DNSName
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("ip_addresses", getBriefMappings(filteredIPAddresses, map[string]string{
+			"id":          "ID",
+			"ip_address":  "Address",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredIPAddresses {
@@ -199,9 +222,10 @@ func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) erro
 
 		mapping["id"] = v.ID
 		mapping["description"] = v.Description
-		mapping["created"] = v.Created.String()
-		mapping["last_updated"] = v.LastUpdated.String()
-		mapping["custom_fields"] = v.CustomFields
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
+		mapping["custom_fields"] = getCustomFields(api, v.CustomFields)
 
 		mapping["ip_address"] = v.Address
 		mapping["address_family"] = v.Family.Label
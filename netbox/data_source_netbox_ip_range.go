@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +12,9 @@ func dataSourceNetboxIPRange() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxIPRangeRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -24,12 +24,13 @@ func dataSourceNetboxIPRange() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IsCIDR,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	contains := d.Get("contains").(string)
 
@@ -44,14 +45,18 @@ func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one ip range returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no ip range found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "ip range"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
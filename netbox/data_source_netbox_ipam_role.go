@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxIPAMRole() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxIPAMRoleRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,12 +30,13 @@ func dataSourceNetboxIPAMRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxIPAMRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -50,11 +51,8 @@ func dataSourceNetboxIPAMRoleRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one ipam role returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no ipam role found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "ipam role"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -66,5 +64,12 @@ func dataSourceNetboxIPAMRoleRead(d *schema.ResourceData, m interface{}) error {
 	if result.Description != "" {
 		d.Set("description", result.Description)
 	}
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -1,11 +1,9 @@
 package netbox
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,7 +12,9 @@ func dataSourceNetboxLocation() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxLocationRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -37,6 +37,7 @@ func dataSourceNetboxLocation() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"tenant": relatedObjectSchema,
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,17 +47,24 @@ func dataSourceNetboxLocation() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"site": relatedObjectSchema,
 			"parent_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
 			},
-		},
+			"parent": relatedObjectSchema,
+			"depth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimLocationsListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -82,11 +90,8 @@ func dataSourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one location returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no location found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "location"); !found {
+		return err
 	}
 
 	location := res.GetPayload().Results[0]
@@ -95,17 +100,27 @@ func dataSourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", location.Description)
 	d.Set("name", location.Name)
 	d.Set("site_id", location.Site.ID)
+	d.Set("site", getRelatedObject(location.Site))
 	d.Set("slug", location.Slug)
 
 	if location.Parent != nil {
 		d.Set("parent_id", location.Parent.ID)
 	}
+	d.Set("parent", getRelatedObject(location.Parent))
+	d.Set("depth", location.Depth)
 	if location.Status != nil {
 		d.Set("status", location.Status.Value)
 	}
 	if location.Tenant != nil {
 		d.Set("tenant_id", location.Tenant.ID)
 	}
+	d.Set("tenant", getRelatedObject(location.Tenant))
+
+	cf := getCustomFields(api, location.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
+	setObjectMetadata(d, location)
 	return nil
 }
@@ -70,6 +70,7 @@ data "netbox_location" "by_slug" {
 					resource.TestCheckResourceAttrPair("data.netbox_location.by_name_and_site", "site_id", "netbox_location.test", "site_id"),
 					resource.TestCheckResourceAttrPair("data.netbox_location.by_name", "tenant_id", "netbox_location.test", "tenant_id"),
 					resource.TestCheckResourceAttrPair("data.netbox_location.sub_by_name", "parent_id", "netbox_location.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_location.sub_by_name", "depth", "1"),
 				),
 			},
 		},
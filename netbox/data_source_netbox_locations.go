@@ -1,11 +1,11 @@
 package netbox
 
 import (
-	"fmt"
+	"net/url"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,7 +25,7 @@ func dataSourceNetboxLocations() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: .",
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:        schema.TypeString,
@@ -35,26 +35,25 @@ func dataSourceNetboxLocations() *schema.Resource {
 					},
 				},
 			},
-			"tags": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "A list of tags to filter on.",
-			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
-				Description:      "The limit of objects to return from the API lookup.",
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"locations": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -87,7 +86,11 @@ func dataSourceNetboxLocations() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+						"depth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					}),
 				},
 			},
 		},
@@ -95,13 +98,10 @@ func dataSourceNetboxLocations() *schema.Resource {
 }
 
 func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimLocationsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -126,34 +126,58 @@ func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error
 			case "status":
 				params.Status = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
-	if tags, ok := d.GetOk("tags"); ok {
-		tagSet := tags.(*schema.Set)
-		for _, tag := range tagSet.List() {
-			tagV := tag.(string)
-			params.Tag = append(params.Tag, tagV)
-		}
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
 	}
-	res, err := api.Dcim.DcimLocationsList(params, nil)
 
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Location, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Dcim.DcimLocationsList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	filteredLocations := res.GetPayload().Results
+	filteredLocations := filterByRegex(d, "name_regex", results, func(v *models.Location) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("locations", getBriefMappings(filteredLocations, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"slug":        "Slug",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]any
 	for _, v := range filteredLocations {
 		var mapping = make(map[string]any)
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["id"] = strconv.FormatInt(v.ID, 10)
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		mapping["name"] = v.Name
 		mapping["slug"] = v.Slug
 		mapping["site_id"] = v.Site.ID
 		mapping["description"] = v.Description
+		mapping["depth"] = v.Depth
 
 		if v.Parent != nil {
 			mapping["parent_id"] = v.Parent.ID
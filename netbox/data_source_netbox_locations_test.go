@@ -215,6 +215,7 @@ data "netbox_locations" "by_parent" {
 					resource.TestCheckResourceAttr("data.netbox_locations.by_parent", "locations.#", "2"),
 					resource.TestCheckResourceAttrPair("data.netbox_locations.by_parent", "locations.0.parent_id", "netbox_location.parent", "id"),
 					resource.TestCheckResourceAttrPair("data.netbox_locations.by_parent", "locations.1.parent_id", "netbox_location.parent", "id"),
+					resource.TestCheckResourceAttr("data.netbox_locations.by_parent", "locations.0.depth", "1"),
 				),
 			},
 		},
@@ -0,0 +1,163 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxPlatforms() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxPlatformsRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of filter to apply to the API query when requesting platforms.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `manufacturer_id`, `config_template_id` or `cf_<custom field>`. All filters of the Netbox API are supported.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value to pass to the specified filter.",
+						},
+					},
+				},
+			},
+			"name_regex": listNameRegexSchema,
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
+			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
+			"platforms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"config_template_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						tagsKey: tagsSchemaRead,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxPlatformsRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	// go-netbox does not know the config template of platforms
+	query := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		for _, f := range filter.(*schema.Set).List() {
+			k := f.(map[string]interface{})["name"]
+			v := f.(map[string]interface{})["value"]
+			query.Add(k.(string), v.(string))
+		}
+	}
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+	if ordering := getListOrdering(d); ordering != nil {
+		query.Set("ordering", *ordering)
+	}
+
+	results, err := apiList(api, "/dcim/platforms/", query, d.Get("limit").(int), d.Get("offset").(int))
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	var platforms []*platform
+	if err := json.Unmarshal(payload, &platforms); err != nil {
+		return err
+	}
+
+	filteredPlatforms := filterByRegex(d, "name_regex", platforms, func(v *platform) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("platforms", getBriefMappings(filteredPlatforms, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"slug":        "Slug",
+			"description": "Description",
+		}))
+	}
+
+	var s []map[string]any
+	for _, v := range filteredPlatforms {
+		var mapping = make(map[string]any)
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
+
+		mapping["id"] = strconv.FormatInt(v.ID, 10)
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		mapping["description"] = v.Description
+		mapping[tagsKey] = getTagListFromNestedTagList(v.Tags)
+
+		if v.Manufacturer != nil {
+			mapping["manufacturer_id"] = v.Manufacturer.ID
+		}
+
+		if v.ConfigTemplate != nil {
+			mapping["config_template_id"] = v.ConfigTemplate.ID
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("platforms", s)
+}
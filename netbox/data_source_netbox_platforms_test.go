@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxPlatformsDataSource_basic(t *testing.T) {
+	testSlug := "pltfs_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_platform" "test" {
+  name               = "%[1]s"
+  manufacturer_id    = netbox_manufacturer.test.id
+  config_template_id = netbox_config_template.test.id
+}
+
+resource "netbox_platform" "other" {
+  name = "%[1]s_other"
+}
+
+data "netbox_platforms" "by_manufacturer" {
+  filter {
+    name  = "manufacturer_id"
+    value = netbox_manufacturer.test.id
+  }
+  depends_on = [netbox_platform.test]
+}
+
+data "netbox_platforms" "by_name_regex" {
+  name_regex = "%[1]s"
+  depends_on = [netbox_platform.test, netbox_platform.other]
+}
+
+data "netbox_platforms" "no_match" {
+  filter {
+    name  = "name"
+    value = "non-existent"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_platforms.by_manufacturer", "platforms.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_platforms.by_manufacturer", "platforms.0.id", "netbox_platform.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_platforms.by_manufacturer", "platforms.0.name", testName),
+					resource.TestCheckResourceAttrPair("data.netbox_platforms.by_manufacturer", "platforms.0.manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_platforms.by_manufacturer", "platforms.0.config_template_id", "netbox_config_template.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_platforms.by_name_regex", "platforms.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_platforms.no_match", "platforms.#", "0"),
+				),
+			},
+		},
+	})
+}
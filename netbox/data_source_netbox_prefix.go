@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +12,9 @@ func dataSourceNetboxPrefix() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxPrefixRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -49,6 +49,7 @@ func dataSourceNetboxPrefix() *schema.Resource {
 				Computed:     true,
 				AtLeastOneOf: []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
+			"role": relatedObjectSchema,
 			"prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -67,16 +68,19 @@ func dataSourceNetboxPrefix() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
+			"vrf": relatedObjectSchema,
 			"vlan_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				AtLeastOneOf: []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
+			"vlan": relatedObjectSchema,
 			"site_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				AtLeastOneOf: []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
+			"site": relatedObjectSchema,
 			"tag": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -95,12 +99,12 @@ for more information on available lookup expressions.`,
 				Computed: true,
 			},
 			"tags": tagsSchemaRead,
-		},
+		}),
 	}
 }
 
 func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamPrefixesListParams()
 
@@ -160,11 +164,8 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than prefix returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no prefix found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "prefix"); !found {
+		return err
 	}
 
 	result := res.GetPayload().Results[0]
@@ -176,23 +177,29 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("family", int(*result.Family.Value))
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 
-	cf := getCustomFields(result.CustomFields)
+	cf := getCustomFields(api, result.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 	if result.Role != nil {
 		d.Set("role_id", result.Role.ID)
 	}
+	d.Set("role", getRelatedObject(result.Role))
 	if result.Vrf != nil {
 		d.Set("vrf_id", result.Vrf.ID)
 	}
+	d.Set("vrf", getRelatedObject(result.Vrf))
 	if result.Vlan != nil {
 		d.Set("vlan_vid", result.Vlan.Vid)
 		d.Set("vlan_id", result.Vlan.ID)
+		d.Set("vlan", getRelatedObject(result.Vlan))
 	}
 	if result.Site != nil {
 		d.Set("site_id", result.Site.ID)
 	}
+	d.Set("site", getRelatedObject(result.Site))
 	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	setObjectMetadata(d, result)
 	return nil
 }
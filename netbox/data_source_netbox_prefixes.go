@@ -1,11 +1,11 @@
 package netbox
 
 import (
-	"fmt"
+	"net/url"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,7 +25,7 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 						"name": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: `prefix`, `contains`, `vlan_vid`, `vrf_id`, `vlan_id`, `status`, `site_id`, & `tag`.",
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:        schema.TypeString,
@@ -40,13 +40,19 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
-				Description:      "The limit of objects to return from the API lookup.",
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"prefixes": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -80,7 +86,7 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 							Computed: true,
 						},
 						"tags": tagsSchemaRead,
-					},
+					}),
 				},
 			},
 		},
@@ -88,14 +94,11 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 }
 
 func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamPrefixesListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -121,26 +124,52 @@ func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
 				params.Status = &vString
 			case "site_id":
 				params.SiteID = &vString
-			case "tag":
-				params.Tag = []string{vString}
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Ipam.IpamPrefixesList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Prefix, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Ipam.IpamPrefixesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	filteredPrefixes := res.GetPayload().Results
+	filteredPrefixes := results
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("prefixes", getBriefMappings(filteredPrefixes, map[string]string{
+			"id":          "ID",
+			"prefix":      "Prefix",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredPrefixes {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["id"] = v.ID
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		mapping["prefix"] = v.Prefix
 		mapping["description"] = v.Description
 		if v.Vlan != nil {
@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxQuery() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxQueryRead,
+		Description: `:meta:subcategory:Extras:Lists the objects of an arbitrary REST API endpoint of Netbox, including endpoints of plugins, and returns them as JSON. All pages of the results are read. Use ` + "`jsondecode()`" + ` to access the results.`,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(rawObjectPathPattern, "must be an API path like dcim/devices"),
+				Description:  "The API path of the endpoint relative to `/api`, e.g. `dcim/devices` or `plugins/bgp/session`.",
+			},
+			"filters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Filters of the endpoint, e.g. `{ site = \"frankfurt-1\" }`.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
+			},
+			"offset":   listOffsetSchema,
+			"order_by": listOrderBySchema,
+			"results": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON encoded list of the matching objects.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxQueryRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	query := url.Values{}
+	for key, value := range d.Get("filters").(map[string]interface{}) {
+		query.Set(key, value.(string))
+	}
+
+	if ordering := getListOrdering(d); ordering != nil {
+		query.Set("ordering", *ordering)
+	}
+
+	results, err := apiList(api, rawObjectPath(d.Get("path").(string)), query, d.Get("limit").(int), d.Get("offset").(int))
+	if err != nil {
+		return err
+	}
+	if results == nil {
+		results = []interface{}{}
+	}
+
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("results", string(resultsJSON))
+}
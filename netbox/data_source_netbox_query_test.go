@@ -0,0 +1,46 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxQueryDataSource_basic(t *testing.T) {
+	testSlug := "query_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+data "netbox_query" "test" {
+  depends_on = [netbox_tag.test]
+  path       = "extras/tags"
+  filters = {
+    name = "%[1]s"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.netbox_query.test", "results", func(value string) error {
+						var results []map[string]interface{}
+						if err := json.Unmarshal([]byte(value), &results); err != nil {
+							return err
+						}
+						if len(results) != 1 || results[0]["name"] != testName {
+							return fmt.Errorf("expected the tag %s, got %s", testName, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
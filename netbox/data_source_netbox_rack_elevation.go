@@ -0,0 +1,135 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxRackElevation() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxRackElevationRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Returns the units of one face of a rack and whether they are occupied, like the rack elevation of Netbox.`,
+		Schema: map[string]*schema.Schema{
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"face": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceRackFaceOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceRackFaceOptions),
+			},
+			"exclude_device_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "A device whose units are returned as free, e.g. to find a new position for it.",
+			},
+			"unit": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"position": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The position of the unit, e.g. `42` for U42.",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"occupied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"device_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The device installed in the unit, or `0` if there is none.",
+						},
+						"device_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The units of the rack in the order of the elevation, i.e. top to bottom unless the units of the rack are descending.",
+			},
+		},
+	}
+}
+
+// getRackElevationUnits returns the units of the rack elevation response of
+// Netbox.
+func getRackElevationUnits(results []interface{}) ([]map[string]interface{}, error) {
+	units := []map[string]interface{}{}
+	for _, result := range results {
+		unit, ok := result.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected unit in rack elevation response: %v", result)
+		}
+
+		// The position is a decimal, which is a string or a number depending
+		// on the settings of Netbox.
+		var position float64
+		switch v := unit["id"].(type) {
+		case json.Number:
+			position, _ = v.Float64()
+		case string:
+			position, _ = strconv.ParseFloat(v, 64)
+		}
+		name, _ := unit["name"].(string)
+		occupied, _ := unit["occupied"].(bool)
+
+		var deviceID json.Number
+		var deviceName string
+		if device, ok := unit["device"].(map[string]interface{}); ok {
+			deviceID, _ = device["id"].(json.Number)
+			deviceName, _ = device["name"].(string)
+		}
+
+		units = append(units, map[string]interface{}{
+			"position":    position,
+			"name":        name,
+			"occupied":    occupied,
+			"device_id":   jsonNumberToInt(deviceID),
+			"device_name": deviceName,
+		})
+	}
+	return units, nil
+}
+
+func dataSourceNetboxRackElevationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	rackID := strconv.Itoa(d.Get("rack_id").(int))
+	face := d.Get("face").(string)
+
+	query := url.Values{}
+	query.Set("face", face)
+	query.Set("render", "json")
+	if excludeDeviceID, ok := d.GetOk("exclude_device_id"); ok {
+		query.Set("exclude", strconv.Itoa(excludeDeviceID.(int)))
+	}
+
+	results, err := apiList(api, "/dcim/racks/"+rackID+"/elevation/", query, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	units, err := getRackElevationUnits(results)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(rackID + "/" + face)
+	d.Set("unit", units)
+	return nil
+}
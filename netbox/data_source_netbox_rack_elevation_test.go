@@ -0,0 +1,130 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxRackElevationDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("rack_elevation_ds_basic")
+	setUp := fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 4
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height = 2
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+  rack_id = netbox_rack.test.id
+  rack_face = "front"
+  rack_position = 2
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + `
+data "netbox_rack_elevation" "test" {
+  rack_id = netbox_rack.test.id
+  depends_on = [netbox_device.test]
+}
+
+data "netbox_rack_elevation" "exclude" {
+  rack_id = netbox_rack.test.id
+  exclude_device_id = netbox_device.test.id
+  depends_on = [netbox_device.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.#", "4"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.0.position", "4"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.0.name", "U4"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.0.occupied", "false"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.0.device_id", "0"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.1.occupied", "true"),
+					resource.TestCheckResourceAttrPair("data.netbox_rack_elevation.test", "unit.1.device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.1.device_name", testName),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.2.occupied", "true"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.test", "unit.3.occupied", "false"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.exclude", "unit.1.occupied", "false"),
+					resource.TestCheckResourceAttr("data.netbox_rack_elevation.exclude", "unit.2.occupied", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetRackElevationUnits(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/racks/1/elevation/", r.URL.Path)
+		assert.Equal(t, "rear", r.URL.Query().Get("face"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+  "count": 3,
+  "next": null,
+  "previous": null,
+  "results": [
+    {"id": 2.0, "name": "U2", "face": {"value": "rear", "label": "Rear"}, "device": null, "occupied": false},
+    {"id": "1.5", "name": "U1.5", "face": {"value": "rear", "label": "Rear"}, "device": {"id": 10, "name": "server"}, "occupied": true},
+    {"id": 1, "name": "U1", "face": {"value": "rear", "label": "Rear"}, "device": {"id": 10, "name": "server"}, "occupied": true}
+  ]
+}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	results, err := apiList(api, "/dcim/racks/1/elevation/", map[string][]string{"face": {"rear"}}, 0, 0)
+	assert.NoError(t, err)
+
+	units, err := getRackElevationUnits(results)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"position": 2.0, "name": "U2", "occupied": false, "device_id": 0, "device_name": ""},
+		{"position": 1.5, "name": "U1.5", "occupied": true, "device_id": 10, "device_name": "server"},
+		{"position": 1.0, "name": "U1", "occupied": true, "device_id": 10, "device_name": "server"},
+	}, units)
+
+	_, err = getRackElevationUnits([]interface{}{"U1"})
+	assert.Error(t, err)
+}
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxRackRole() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxRackRoleRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,13 +30,14 @@ func dataSourceNetboxRackRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchemaRead,
-		},
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := dcim.NewDcimRackRolesListParams()
@@ -49,11 +50,8 @@ func dataSourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one rack role returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no rack role found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "rack role"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -62,5 +60,12 @@ func dataSourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", result.Description)
 	d.Set("color_hex", result.Color)
 	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
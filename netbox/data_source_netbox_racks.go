@@ -2,10 +2,10 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,8 +22,9 @@ func dataSourceNetboxRacks() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -32,17 +33,24 @@ func dataSourceNetboxRacks() *schema.Resource {
 					},
 				},
 			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"racks": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -140,7 +148,7 @@ func dataSourceNetboxRacks() *schema.Resource {
 							Type:     schema.TypeMap,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -148,14 +156,11 @@ func dataSourceNetboxRacks() *schema.Resource {
 }
 
 func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimRacksListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -214,25 +219,54 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 			case "width":
 				params.Width = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Dcim.DcimRacksList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Rack, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Dcim.DcimRacksList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredRacks := res.GetPayload().Results
+	filteredRacks := filterByRegex(d, "name_regex", results, func(v *models.Rack) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("racks", getBriefMappings(filteredRacks, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredRacks {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["id"] = v.ID
 		mapping["name"] = v.Name
@@ -273,7 +307,7 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 		mapping["mounting_depth"] = v.MountingDepth
 		mapping["description"] = v.Description
 		mapping["comments"] = v.Comments
-		mapping["custom_fields"] = getCustomFields(v.CustomFields)
+		mapping["custom_fields"] = getCustomFields(api, v.CustomFields)
 
 		s = append(s, mapping)
 	}
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxRegion() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxRegionRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"filter": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -54,12 +54,13 @@ func dataSourceNetboxRegion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := dcim.NewDcimRegionsListParams()
 
@@ -92,11 +93,8 @@ func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one region returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no region found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "region"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -106,5 +104,12 @@ func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
 	if result.Parent != nil {
 		d.Set("parent_region_id", result.Parent.ID)
 	}
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
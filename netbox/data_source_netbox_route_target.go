@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +12,9 @@ func dataSourceNetboxRouteTarget() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxRouteTargetRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringLenBetween(1, 21),
@@ -28,13 +28,14 @@ func dataSourceNetboxRouteTarget() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -50,11 +51,8 @@ func dataSourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one route target returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no route target found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "route target"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -69,5 +67,11 @@ func dataSourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) erro
 		d.Set(tagsKey, result.Tags)
 	}
 
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxSite() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxSiteRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -53,6 +53,7 @@ func dataSourceNetboxSite() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"group": relatedObjectSchema,
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -61,6 +62,7 @@ func dataSourceNetboxSite() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"region": relatedObjectSchema,
 			"site_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -69,16 +71,18 @@ func dataSourceNetboxSite() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"tenant": relatedObjectSchema,
 			"time_zone": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := dcim.NewDcimSitesListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -100,11 +104,8 @@ func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one site returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no site found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "site"); !found {
+		return err
 	}
 
 	site := res.GetPayload().Results[0]
@@ -122,15 +123,24 @@ func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 	if site.Group != nil {
 		d.Set("group_id", site.Group.ID)
 	}
+	d.Set("group", getRelatedObject(site.Group))
 	if site.Region != nil {
 		d.Set("region_id", site.Region.ID)
 	}
+	d.Set("region", getRelatedObject(site.Region))
 	if site.Status != nil {
 		d.Set("status", site.Status.Value)
 	}
 	if site.Tenant != nil {
 		d.Set("tenant_id", site.Tenant.ID)
 	}
+	d.Set("tenant", getRelatedObject(site.Tenant))
+
+	cf := getCustomFields(api, site.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
+	setObjectMetadata(d, site)
 	return nil
 }
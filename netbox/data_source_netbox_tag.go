@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxTag() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxTagRead,
 		Description: `:meta:subcategory:Extras:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -26,12 +26,12 @@ func dataSourceNetboxTag() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+		}),
 	}
 }
 
 func dataSourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := extras.NewExtrasTagsListParams()
@@ -44,11 +44,8 @@ func dataSourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one tag returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no tag found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "tag"); !found {
+		return err
 	}
 
 	result := res.GetPayload().Results[0]
@@ -56,5 +53,7 @@ func dataSourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", result.Name)
 	d.Set("slug", result.Slug)
 	d.Set("description", result.Description)
+
+	setObjectMetadata(d, result)
 	return nil
 }
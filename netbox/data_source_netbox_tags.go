@@ -2,10 +2,10 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,8 +22,9 @@ func dataSourceNetboxTags() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -32,17 +33,22 @@ func dataSourceNetboxTags() *schema.Resource {
 					},
 				},
 			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"offset":   listOffsetSchema,
+			"brief":    listBriefSchema,
+			"order_by": listOrderBySchema,
 			"tags": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"tag_id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -63,7 +69,7 @@ func dataSourceNetboxTags() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -71,14 +77,11 @@ func dataSourceNetboxTags() *schema.Resource {
 }
 
 func dataSourceNetboxTagsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := extras.NewExtrasTagsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		filterParams := filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -121,24 +124,54 @@ func dataSourceNetboxTagsRead(d *schema.ResourceData, m interface{}) error {
 			case "slug__isw":
 				params.SlugIsw = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Extras.ExtrasTagsList(params, nil)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Tag, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Extras.ExtrasTagsList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
+	filteredTags := filterByRegex(d, "name_regex", results, func(v *models.Tag) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("tags", getBriefMappings(filteredTags, map[string]string{
+			"tag_id":      "ID",
+			"name":        "Name",
+			"slug":        "Slug",
+			"description": "Description",
+			"color":       "Color",
+		}))
+	}
+
 	var s []map[string]interface{}
-	results := res.GetPayload().Results
-	for _, v := range results {
+	for _, v := range filteredTags {
 		mapping := make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["tag_id"] = v.ID
 		mapping["name"] = v.Name
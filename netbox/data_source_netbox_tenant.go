@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxTenant() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxTenantRead,
 		Description: `:meta:subcategory:Tenancy:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -30,16 +30,18 @@ func dataSourceNetboxTenant() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"group": relatedObjectSchema,
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := tenancy.NewTenancyTenantsListParams()
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -58,11 +60,8 @@ func dataSourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one tenant returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no tenant found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "tenant"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -72,5 +71,13 @@ func dataSourceNetboxTenantRead(d *schema.ResourceData, m interface{}) error {
 	if result.Group != nil {
 		d.Set("group_id", result.Group.ID)
 	}
+	d.Set("group", getRelatedObject(result.Group))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
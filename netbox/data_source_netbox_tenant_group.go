@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +11,9 @@ func dataSourceNetboxTenantGroup() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxTenantGroupRead,
 		Description: `:meta:subcategory:Tenancy:`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,12 +30,13 @@ func dataSourceNetboxTenantGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	params := tenancy.NewTenancyTenantGroupsListParams()
@@ -48,11 +49,8 @@ func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one tenant group returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no tenant group found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "tenant group"); !found {
+		return err
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -62,5 +60,12 @@ func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, m interface{}) erro
 	if result.Parent != nil {
 		d.Set("parent_id", result.Parent.ID)
 	}
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
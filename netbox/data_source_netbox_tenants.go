@@ -2,9 +2,8 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -23,8 +22,9 @@ func dataSourceNetboxTenants() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -33,17 +33,24 @@ func dataSourceNetboxTenants() *schema.Resource {
 					},
 				},
 			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"tenants": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -60,14 +67,6 @@ func dataSourceNetboxTenants() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"created": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"last_updated": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
 						"comments": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -141,7 +140,7 @@ func dataSourceNetboxTenants() *schema.Resource {
 								},
 							},
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -149,14 +148,11 @@ func dataSourceNetboxTenants() *schema.Resource {
 }
 
 func dataSourceNetboxTenantsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := tenancy.NewTenancyTenantsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
 		for _, f := range filterParams.List() {
@@ -169,21 +165,48 @@ func dataSourceNetboxTenantsRead(d *schema.ResourceData, m interface{}) error {
 			case "slug":
 				params.Slug = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Tenancy.TenancyTenantsList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.Tenant, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Tenancy.TenancyTenantsList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredTenants := res.GetPayload().Results
+	filteredTenants := filterByRegex(d, "name_regex", results, func(v *models.Tenant) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("tenants", getBriefMappings(filteredTenants, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"slug":        "Slug",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredTenants {
@@ -193,10 +216,11 @@ func dataSourceNetboxTenantsRead(d *schema.ResourceData, m interface{}) error {
 		mapping["name"] = v.Name
 		mapping["slug"] = v.Slug
 		mapping["description"] = v.Description
-		mapping["created"] = v.Created.String()
-		mapping["last_updated"] = v.LastUpdated.String()
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 		mapping["comments"] = v.Comments
-		mapping["custom_fields"] = v.CustomFields
+		mapping["custom_fields"] = getCustomFields(api, v.CustomFields)
 
 		mapping["site_count"] = v.SiteCount
 		mapping["rack_count"] = v.RackCount
@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxVirtualChassis() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxVirtualChassisRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "id"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "id"},
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"position": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			tagsKey: tagsSchemaRead,
+		}),
+	}
+}
+
+func dataSourceNetboxVirtualChassisRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	params := dcim.NewDcimVirtualChassisListParams()
+
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		params.Name = &name
+	}
+
+	if id, ok := d.Get("id").(string); ok && id != "" {
+		params.SetID(&id)
+	}
+
+	limit := int64(2) // Limit of 2 is enough
+	params.Limit = &limit
+
+	res, err := api.Dcim.DcimVirtualChassisList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "virtual chassis"); !found {
+		return err
+	}
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("name", result.Name)
+	d.Set("domain", result.Domain)
+	d.Set("description", result.Description)
+	d.Set("comments", result.Comments)
+	if result.Master != nil {
+		d.Set("master_id", result.Master.ID)
+	} else {
+		d.Set("master_id", nil)
+	}
+	d.Set("member_count", result.MemberCount)
+
+	members, err := getVirtualChassisMembers(api, result.ID)
+	if err != nil {
+		return err
+	}
+	d.Set("members", members)
+
+	if result.CustomFields != nil {
+		d.Set("custom_fields", getCustomFields(api, result.CustomFields))
+	}
+	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+
+	setObjectMetadata(d, result)
+	return nil
+}
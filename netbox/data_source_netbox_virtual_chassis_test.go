@@ -0,0 +1,51 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVirtualChassisDataSource_basic(t *testing.T) {
+	testSlug := "vc_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualChassisMemberDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_chassis" "test" {
+  name      = "%[1]s"
+  domain    = "%[1]s.example.com"
+  master_id = netbox_device.test[0].id
+
+  member {
+    device_id = netbox_device.test[0].id
+    position  = 1
+  }
+  member {
+    device_id = netbox_device.test[1].id
+    position  = 2
+  }
+}
+
+data "netbox_virtual_chassis" "by_name" {
+  name = netbox_virtual_chassis.test.name
+}
+
+data "netbox_virtual_chassis" "by_id" {
+  id = netbox_virtual_chassis.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_chassis.by_name", "id", "netbox_virtual_chassis.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_chassis.by_name", "domain", testName+".example.com"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_chassis.by_name", "master_id", "netbox_device.test.0", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_chassis.by_name", "member_count", "2"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_chassis.by_name", "members.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_chassis.by_id", "name", "netbox_virtual_chassis.test", "name"),
+				),
+			},
+		},
+	})
+}
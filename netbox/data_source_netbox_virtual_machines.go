@@ -3,15 +3,12 @@ package netbox
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
-	"regexp"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxVirtualMachine() *schema.Resource {
@@ -25,8 +22,9 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -35,20 +33,22 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 					},
 				},
 			},
-			"name_regex": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsValidRegExp,
-			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"vms": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
 						"cluster_id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -144,7 +144,7 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -152,13 +152,13 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 }
 
 func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := virtualization.NewVirtualizationVirtualMachinesListParams()
 
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
-		var tags []string
 		for _, f := range filterParams.List() {
 			k := f.(map[string]interface{})["name"]
 			v := f.(map[string]interface{})["value"]
@@ -182,46 +182,57 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 				params.Site = &vString
 			case "tenant_id":
 				params.TenantID = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
 			case "status":
 				params.Status = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil)
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.VirtualMachineWithConfigContext, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	var filteredVms []*models.VirtualMachineWithConfigContext
-	if nameRegex, ok := d.GetOk("name_regex"); ok {
-		r := regexp.MustCompile(nameRegex.(string))
-		for _, vm := range res.GetPayload().Results {
-			if r.MatchString(*vm.Name) {
-				filteredVms = append(filteredVms, vm)
-			}
-		}
-	} else {
-		filteredVms = res.GetPayload().Results
+	filteredVms := filterByRegex(d, "name_regex", results, func(vm *models.VirtualMachineWithConfigContext) string {
+		return *vm.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("vms", getBriefMappings(filteredVms, map[string]string{
+			"vm_id":       "ID",
+			"name":        "Name",
+			"description": "Description",
+		}))
 	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredVms {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 		if v.Cluster != nil {
 			mapping["cluster_id"] = v.Cluster.ID
 		}
@@ -241,7 +252,7 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 			}
 		}
 		if v.CustomFields != nil {
-			mapping["custom_fields"] = v.CustomFields
+			mapping["custom_fields"] = getCustomFields(api, v.CustomFields)
 		}
 		if v.Disk != nil {
 			mapping["disk_size_gb"] = *v.Disk
@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +12,9 @@ func dataSourceNetboxVlan() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxVlanRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"vid": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -33,6 +33,7 @@ func dataSourceNetboxVlan() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"group": relatedObjectSchema,
 			"role": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -51,12 +52,13 @@ func dataSourceNetboxVlan() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := ipam.NewIpamVlansListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -80,11 +82,8 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one vlan returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no vlan found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "vlan"); !found {
+		return err
 	}
 
 	vlan := res.GetPayload().Results[0]
@@ -98,6 +97,7 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 	if vlan.Group != nil {
 		d.Set("group_id", vlan.Group.ID)
 	}
+	d.Set("group", getRelatedObject(vlan.Group))
 	if vlan.Role != nil {
 		d.Set("role", vlan.Role.ID)
 	}
@@ -108,5 +108,11 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant", vlan.Tenant.ID)
 	}
 
+	cf := getCustomFields(api, vlan.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, vlan)
 	return nil
 }
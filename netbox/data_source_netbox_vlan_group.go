@@ -1,10 +1,8 @@
 package netbox
 
 import (
-	"errors"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +12,9 @@ func dataSourceNetboxVlanGroup() *schema.Resource {
 	return &schema.Resource{
 		Read:        dataSourceNetboxVlanGroupRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"allow_missing":    allowMissingSchema,
+			"fail_on_multiple": failOnMultipleSchema,
 			"name": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -54,12 +54,13 @@ func dataSourceNetboxVlanGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+			customFieldsKey: customFieldsSchemaRead,
+		}),
 	}
 }
 
 func dataSourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	params := ipam.NewIpamVlanGroupsListParams()
 
 	params.Limit = int64ToPtr(2)
@@ -81,11 +82,8 @@ func dataSourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one vlan group returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no vlan group found matching filter")
+	if found, err := checkSingleResult(d, *res.GetPayload().Count, "vlan group"); !found {
+		return err
 	}
 
 	result := res.GetPayload().Results[0]
@@ -96,5 +94,12 @@ func dataSourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error
 	d.Set("max_vid", result.MaxVid)
 	d.Set("vlan_count", result.VlanCount)
 	d.Set("description", result.Description)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, result)
 	return nil
 }
@@ -2,10 +2,10 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,8 +22,9 @@ func dataSourceNetboxVlans() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -32,17 +33,25 @@ func dataSourceNetboxVlans() *schema.Resource {
 					},
 				},
 			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"vlans": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"vid": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -82,7 +91,7 @@ func dataSourceNetboxVlans() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -90,17 +99,13 @@ func dataSourceNetboxVlans() *schema.Resource {
 }
 
 func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamVlansListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
-		var tags []string
 		for _, f := range filterParams.List() {
 			k := f.(map[string]interface{})["name"]
 			v := f.(map[string]interface{})["value"]
@@ -126,9 +131,6 @@ func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
 				params.GroupID = &vString
 			case "group_id__n":
 				params.GroupIDn = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
 			case "tenant":
 				params.Tenant = &vString
 			case "tenant__n":
@@ -152,29 +154,59 @@ func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
 			case "status":
 				params.Status = &vString
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Ipam.IpamVlansList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.VLAN, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Ipam.IpamVlansList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredVlans := res.GetPayload().Results
+	filteredVlans := filterByRegex(d, "name_regex", results, func(v *models.VLAN) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("vlans", getBriefMappings(filteredVlans, map[string]string{
+			"vid":         "Vid",
+			"name":        "Name",
+			"description": "Description",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredVlans {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["vid"] = v.Vid
 		mapping["name"] = v.Name
 		mapping["description"] = v.Description
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		if v.Group != nil {
 			mapping["group_id"] = v.Group.ID
 		}
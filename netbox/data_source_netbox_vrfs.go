@@ -2,10 +2,10 @@ package netbox
 
 import (
 	"errors"
-	"fmt"
+	"net/url"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,8 +22,9 @@ func dataSourceNetboxVrfs() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the filter, e.g. `status`, `site` or `cf_<custom field>`. All filters of the Netbox API are supported.",
 						},
 						"value": {
 							Type:     schema.TypeString,
@@ -32,17 +33,25 @@ func dataSourceNetboxVrfs() *schema.Resource {
 					},
 				},
 			},
+			"name_regex": listNameRegexSchema,
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 				Default:          0,
+				Description:      "The maximum number of objects to return. By default, all matching objects are returned.",
 			},
+			"tags":                listTagsSchema,
+			"custom_field_filter": listCustomFieldFilterSchema,
+			"offset":              listOffsetSchema,
+			"brief":               listBriefSchema,
+			"order_by":            listOrderBySchema,
 			"vrfs": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObjectMetadata(map[string]*schema.Schema{
+						customFieldsKey: customFieldsSchemaRead,
 						"id": {
 							Type:     schema.TypeInt,
 							Computed: true,
@@ -63,7 +72,7 @@ func dataSourceNetboxVrfs() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
-					},
+					}),
 				},
 			},
 		},
@@ -71,17 +80,13 @@ func dataSourceNetboxVrfs() *schema.Resource {
 }
 
 func dataSourceNetboxVrfsRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	params := ipam.NewIpamVrfsListParams()
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
-
+	query := url.Values{}
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
-		var tags []string
 		for _, f := range filterParams.List() {
 			k := f.(map[string]interface{})["name"]
 			v := f.(map[string]interface{})["value"]
@@ -111,31 +116,59 @@ func dataSourceNetboxVrfsRead(d *schema.ResourceData, m interface{}) error {
 				params.TenantID = &vString
 			case "tenant_id__n":
 				params.TenantIDn = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
 			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+				query.Add(k.(string), v.(string))
 			}
 		}
 	}
 
-	res, err := api.Ipam.IpamVrfsList(params, nil)
+	addListTagsQuery(d, query)
+	addListCustomFieldQuery(d, query)
+	if d.Get("brief").(bool) {
+		query.Set("brief", "true")
+	}
+
+	params.Ordering = getListOrdering(d)
+	results, err := listAll(int64(d.Get("limit").(int)), int64(d.Get("offset").(int)), func(limit, offset int64) ([]*models.VRF, int64, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := api.Ipam.IpamVrfsList(params, nil, withQueryParams(query))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count == int64(0) {
+	if len(results) == 0 {
 		return errors.New("no result")
 	}
 
-	filteredVrfs := res.GetPayload().Results
+	filteredVrfs := filterByRegex(d, "name_regex", results, func(v *models.VRF) string {
+		return *v.Name
+	})
+
+	if d.Get("brief").(bool) {
+		d.SetId(id.UniqueId())
+		return d.Set("vrfs", getBriefMappings(filteredVrfs, map[string]string{
+			"id":          "ID",
+			"name":        "Name",
+			"description": "Description",
+			"rd":          "Rd",
+		}))
+	}
 
 	var s []map[string]interface{}
 	for _, v := range filteredVrfs {
 		var mapping = make(map[string]interface{})
+		for key, value := range getObjectMetadata(v) {
+			mapping[key] = value
+		}
 
 		mapping["id"] = v.ID
+		mapping[customFieldsKey] = getCustomFields(api, v.CustomFields)
 		mapping["name"] = v.Name
 		mapping["description"] = v.Description
 		if v.Rd != nil {
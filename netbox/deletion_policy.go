@@ -0,0 +1,70 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const deletionPolicyKey = "deletion_policy"
+
+const (
+	deletionPolicyDelete  = "delete"
+	deletionPolicyPrevent = "prevent"
+	deletionPolicyAbandon = "abandon"
+)
+
+// deletionPolicyResources are the resources whose objects are hard to
+// recreate or that many other objects depend on, so destroying them by
+// accident must be preventable.
+var deletionPolicyResources = []string{
+	"netbox_aggregate",
+	"netbox_circuit",
+	"netbox_cluster",
+	"netbox_device",
+	"netbox_ip_range",
+	"netbox_location",
+	"netbox_prefix",
+	"netbox_rack",
+	"netbox_region",
+	"netbox_site",
+	"netbox_tenant",
+	"netbox_virtual_machine",
+	"netbox_vrf",
+}
+
+// wrapDeletionPolicy adds the deletion_policy attribute to the given resource
+// and makes its delete function honor it. It must be applied after
+// wrapAPIErrors.
+func wrapDeletionPolicy(r *schema.Resource) {
+	r.Schema[deletionPolicyKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{deletionPolicyDelete, deletionPolicyPrevent, deletionPolicyAbandon}, false),
+		Description:  "What happens to the object in Netbox when the resource is destroyed or replaced. With `delete`, the object is deleted. With `prevent`, destroying the resource fails. With `abandon`, the resource is only removed from the state and the object is kept in Netbox. The policy must be applied before it takes effect. Defaults to `delete`.",
+	}
+
+	deleteFunc := r.DeleteContext
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		switch d.Get(deletionPolicyKey).(string) {
+		case deletionPolicyPrevent:
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Deletion prevented",
+				Detail:   fmt.Sprintf("The object with ID %s has deletion_policy = \"prevent\" and can not be deleted. Set deletion_policy to \"delete\" and apply before destroying it.", d.Id()),
+			}}
+		case deletionPolicyAbandon:
+			id := d.Id()
+			d.SetId("")
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Object abandoned",
+				Detail:   fmt.Sprintf("The object with ID %s has deletion_policy = \"abandon\". It was removed from the state, but still exists in Netbox.", id),
+			}}
+		}
+		return deleteFunc(ctx, d, m)
+	}
+}
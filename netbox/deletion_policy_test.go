@@ -0,0 +1,50 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapDeletionPolicy(t *testing.T) {
+	deleted := false
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			deleted = true
+			d.SetId("")
+			return nil
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapDeletionPolicy(r)
+
+	for _, tc := range []struct {
+		policy   string
+		deleted  bool
+		severity diag.Severity
+		inState  bool
+	}{
+		{"", true, -1, false},
+		{deletionPolicyDelete, true, -1, false},
+		{deletionPolicyPrevent, false, diag.Error, true},
+		{deletionPolicyAbandon, false, diag.Warning, false},
+	} {
+		deleted = false
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{deletionPolicyKey: tc.policy})
+		d.SetId("1")
+		diags := r.DeleteContext(context.Background(), d, nil)
+
+		assert.Equal(t, tc.deleted, deleted, tc.policy)
+		assert.Equal(t, tc.inState, d.Id() != "", tc.policy)
+		if tc.severity < 0 {
+			assert.Empty(t, diags, tc.policy)
+		} else {
+			assert.Len(t, diags, 1, tc.policy)
+			assert.Equal(t, tc.severity, diags[0].Severity, tc.policy)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package netbox
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clearEmptyStrings returns body as a JSON object in which the given string
+// attributes are set to "" when they are empty in d. The go-netbox models
+// omit empty strings, so without this Netbox would keep the old value of an
+// attribute that was removed from the configuration.
+func clearEmptyStrings(d *schema.ResourceData, body interface{}, keys ...string) (map[string]interface{}, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if d.Get(key).(string) == "" {
+			object[key] = ""
+		}
+	}
+	return object, nil
+}
+
+type clearedStringsWriter struct {
+	params runtime.ClientRequestWriter
+	d      *schema.ResourceData
+	keys   []string
+}
+
+func (w clearedStringsWriter) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	return w.params.WriteToRequest(clearedStringsRequest{ClientRequest: r, writer: w}, reg)
+}
+
+type clearedStringsRequest struct {
+	runtime.ClientRequest
+	writer clearedStringsWriter
+}
+
+func (r clearedStringsRequest) SetBodyParam(body interface{}) error {
+	object, err := clearEmptyStrings(r.writer.d, body, r.writer.keys...)
+	if err != nil {
+		return err
+	}
+	return r.ClientRequest.SetBodyParam(object)
+}
+
+// withClearedStrings returns a go-netbox client option that applies
+// clearEmptyStrings to the body of the request. It must be passed after
+// withRequestBody.
+func withClearedStrings(d *schema.ResourceData, keys ...string) func(*runtime.ClientOperation) {
+	return func(op *runtime.ClientOperation) {
+		op.Params = clearedStringsWriter{params: op.Params, d: d, keys: keys}
+	}
+}
@@ -0,0 +1,55 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithClearedStrings(t *testing.T) {
+	var patched map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/dcim/sites/1/", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"comments": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}, map[string]interface{}{"comments": "unchanged"})
+
+	name := "site"
+	data := models.WritableSite{Name: &name, Slug: &name, Comments: "unchanged"}
+	params := dcim.NewDcimSitesPartialUpdateParams().WithID(1).WithData(&data)
+	_, err = api.Dcim.DcimSitesPartialUpdate(params, nil, withRequestBody(&writableSite{WritableSite: data}), withClearedStrings(d, "description", "comments"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", patched["description"])
+	assert.Equal(t, "unchanged", patched["comments"])
+	assert.Equal(t, "site", patched["name"])
+}
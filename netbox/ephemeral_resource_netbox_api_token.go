@@ -0,0 +1,212 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client/users"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const apiTokenEphemeralResourcePrivateID = "token_id"
+
+type apiTokenEphemeralResource struct {
+	api *providerState
+}
+
+type apiTokenEphemeralResourceModel struct {
+	UserID       types.Int64  `tfsdk:"user_id"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Description  types.String `tfsdk:"description"`
+	WriteEnabled types.Bool   `tfsdk:"write_enabled"`
+	Expires      types.String `tfsdk:"expires"`
+	ID           types.Int64  `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure        = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig   = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose            = &apiTokenEphemeralResource{}
+)
+
+// NewAPITokenEphemeralResource returns the ephemeral resource
+// netbox_api_token.
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+func (r *apiTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived Netbox API token for the duration of a Terraform run and deletes it again when the run ends. The token key is never stored in the state. The token is either created for `user_id` with the credentials of the provider or provisioned with `username` and `password`. Deleting the token requires the provider credentials to have permission to delete tokens of that user. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user to create the token for. Exactly one of `user_id` or `username` must be given.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the user to provision the token for. Requires `password`. Exactly one of `user_id` or `username` must be given.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of `username`. Required with `username`.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the token.",
+			},
+			"write_enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether the token may be used for write operations. Defaults to `false`.",
+			},
+			"expires": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "RFC 3339 timestamp after which Netbox rejects the token, as a safeguard in case it cannot be deleted.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the token.",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The token key to authenticate with.",
+			},
+		},
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.api = req.ProviderData.(*providerState)
+}
+
+func (r *apiTokenEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(path.MatchRoot("user_id"), path.MatchRoot("username")),
+		ephemeralvalidator.RequiredTogether(path.MatchRoot("username"), path.MatchRoot("password")),
+	}
+}
+
+func (r *apiTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data apiTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Expires.IsNull() && !data.Expires.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.Expires.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires"), "Invalid Attribute Value", fmt.Sprintf("expires must be an RFC 3339 timestamp: %s", err))
+		}
+	}
+}
+
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var expires *strfmt.DateTime
+	if !data.Expires.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.Expires.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid expiry", err.Error())
+			return
+		}
+		dt := strfmt.DateTime(t)
+		expires = &dt
+	}
+
+	if !data.UserID.IsNull() {
+		params := users.NewUsersTokensCreateParamsWithContext(ctx).WithData(&models.WritableToken{
+			User:         data.UserID.ValueInt64Pointer(),
+			Description:  data.Description.ValueString(),
+			WriteEnabled: data.WriteEnabled.ValueBool(),
+			Expires:      expires,
+			AllowedIps:   []models.IPNetwork{},
+		})
+		res, err := r.api.Users.UsersTokensCreate(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating API token", err.Error())
+			return
+		}
+		data.ID = types.Int64Value(res.GetPayload().ID)
+		data.Key = types.StringValue(res.GetPayload().Key)
+	} else {
+		body := map[string]interface{}{
+			"username":      data.Username.ValueString(),
+			"password":      data.Password.ValueString(),
+			"description":   data.Description.ValueString(),
+			"write_enabled": data.WriteEnabled.ValueBool(),
+		}
+		if expires != nil {
+			body["expires"] = expires.String()
+		}
+		res, err := apiRequest(r.api, "POST", "/users/tokens/provision/", nil, body)
+		if err != nil {
+			resp.Diagnostics.AddError("Error provisioning API token", err.Error())
+			return
+		}
+		token, _ := res.(map[string]interface{})
+		n, ok := token["id"].(json.Number)
+		if !ok {
+			resp.Diagnostics.AddError("Error provisioning API token", "Netbox did not return the ID of the provisioned token.")
+			return
+		}
+		id, err := n.Int64()
+		if err != nil {
+			resp.Diagnostics.AddError("Error provisioning API token", fmt.Sprintf("Netbox returned an invalid ID for the provisioned token: %s", err))
+			return
+		}
+		key, _ := token["key"].(string)
+		data.ID = types.Int64Value(id)
+		data.Key = types.StringValue(key)
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiTokenEphemeralResourcePrivateID, []byte(strconv.FormatInt(data.ID.ValueInt64(), 10)))...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *apiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	value, diags := req.Private.GetKey(ctx, apiTokenEphemeralResourcePrivateID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || value == nil {
+		return
+	}
+
+	id, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid token ID", err.Error())
+		return
+	}
+
+	params := users.NewUsersTokensDeleteParamsWithContext(ctx).WithID(id)
+	if _, err := r.api.Users.UsersTokensDelete(params, nil); err != nil {
+		if errresp, ok := err.(*users.UsersTokensDeleteDefault); ok && errresp.Code() == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting API token", err.Error())
+	}
+}
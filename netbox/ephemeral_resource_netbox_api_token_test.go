@@ -0,0 +1,225 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testConfiguredProviderServer returns the muxed provider server, configured
+// to talk to serverURL.
+func testConfiguredProviderServer(t *testing.T, serverURL string) (tfprotov5.ProviderServer, *tfprotov5.GetProviderSchemaResponse) {
+	ctx := context.Background()
+
+	factory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := testDynamicValue(t, schemaResp.Provider, map[string]tftypes.Value{
+		"server_url":         tftypes.NewValue(tftypes.String, serverURL),
+		"api_token":          tftypes.NewValue(tftypes.String, "07b12b765127747e4afd56cb531b7bf9c61f3c30"),
+		"skip_version_check": tftypes.NewValue(tftypes.Bool, true),
+	})
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	return server, schemaResp
+}
+
+// testDynamicValue encodes values as an object of the given schema. Attributes
+// missing from values are null.
+func testDynamicValue(t *testing.T, s *tfprotov5.Schema, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	objectType := s.ValueType().(tftypes.Object)
+
+	object := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		object[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := values[name]; ok {
+			object[name] = v
+		}
+	}
+
+	value, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, object))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+func TestAPITokenEphemeralResource(t *testing.T) {
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/tokens/provision/":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "ci", body["username"])
+			assert.Equal(t, "secret", body["password"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 12, "key": "0123456789abcdef0123456789abcdef01234567"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/users/tokens/12/":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	server, schemaResp := testConfiguredProviderServer(t, ts.URL)
+	tokenSchema := schemaResp.EphemeralResourceSchemas["netbox_api_token"]
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "netbox_api_token",
+		Config: testDynamicValue(t, tokenSchema, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "ci"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, openResp.Diagnostics)
+
+	result, err := openResp.Result.Unmarshal(tokenSchema.ValueType())
+	assert.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, result.As(&attributes))
+	var key string
+	assert.NoError(t, attributes["key"].As(&key))
+	assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", key)
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "netbox_api_token",
+		Private:  openResp.Private,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, closeResp.Diagnostics)
+	assert.True(t, deleted)
+}
+
+func TestAPITokenEphemeralResourceInvalidResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"key": "0123456789abcdef0123456789abcdef01234567"}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	server, schemaResp := testConfiguredProviderServer(t, ts.URL)
+	tokenSchema := schemaResp.EphemeralResourceSchemas["netbox_api_token"]
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "netbox_api_token",
+		Config: testDynamicValue(t, tokenSchema, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "ci"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, openResp.Diagnostics, 1) {
+		assert.Equal(t, tfprotov5.DiagnosticSeverityError, openResp.Diagnostics[0].Severity)
+		assert.Equal(t, "Error provisioning API token", openResp.Diagnostics[0].Summary)
+	}
+}
+
+func TestEphemeralResourceConfigValidators(t *testing.T) {
+	ctx := context.Background()
+	factory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		typeName  string
+		values    map[string]tftypes.Value
+		invalid   bool
+		attribute *tftypes.AttributePath
+	}{
+		{
+			name:     "APITokenUserID",
+			typeName: "netbox_api_token",
+			values: map[string]tftypes.Value{
+				"user_id": tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		{
+			name:      "APITokenUserIDAndUsername",
+			typeName:  "netbox_api_token",
+			invalid:   true,
+			attribute: tftypes.NewAttributePath().WithAttributeName("user_id"),
+			values: map[string]tftypes.Value{
+				"user_id":  tftypes.NewValue(tftypes.Number, 1),
+				"username": tftypes.NewValue(tftypes.String, "ci"),
+				"password": tftypes.NewValue(tftypes.String, "secret"),
+			},
+		},
+		{
+			name:      "APITokenUsernameWithoutPassword",
+			typeName:  "netbox_api_token",
+			invalid:   true,
+			attribute: tftypes.NewAttributePath().WithAttributeName("username"),
+			values: map[string]tftypes.Value{
+				"username": tftypes.NewValue(tftypes.String, "ci"),
+			},
+		},
+		{
+			name:     "AvailableIPAddressWithoutParent",
+			typeName: "netbox_available_ip_address",
+			invalid:  true,
+			values:   map[string]tftypes.Value{},
+		},
+		{
+			name:      "AvailablePrefixLength",
+			typeName:  "netbox_available_prefix",
+			invalid:   true,
+			attribute: tftypes.NewAttributePath().WithAttributeName("prefix_length"),
+			values: map[string]tftypes.Value{
+				"parent_prefix_id": tftypes.NewValue(tftypes.Number, 1),
+				"prefix_length":    tftypes.NewValue(tftypes.Number, 129),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov5.ValidateEphemeralResourceConfigRequest{
+				TypeName: tt.typeName,
+				Config:   testDynamicValue(t, schemaResp.EphemeralResourceSchemas[tt.typeName], tt.values),
+			})
+			assert.NoError(t, err)
+			if !tt.invalid {
+				assert.Empty(t, resp.Diagnostics)
+				return
+			}
+			if assert.NotEmpty(t, resp.Diagnostics) {
+				assert.Equal(t, tfprotov5.DiagnosticSeverityError, resp.Diagnostics[0].Severity)
+				assert.Equal(t, tt.attribute, resp.Diagnostics[0].Attribute)
+			}
+		})
+	}
+}
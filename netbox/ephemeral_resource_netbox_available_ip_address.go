@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type availableIPAddressEphemeralResource struct {
+	api *providerState
+}
+
+type availableIPAddressEphemeralResourceModel struct {
+	PrefixID  types.Int64  `tfsdk:"prefix_id"`
+	IPRangeID types.Int64  `tfsdk:"ip_range_id"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	VrfID     types.Int64  `tfsdk:"vrf_id"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure        = &availableIPAddressEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &availableIPAddressEphemeralResource{}
+)
+
+// NewAvailableIPAddressEphemeralResource returns the ephemeral resource
+// netbox_available_ip_address.
+func NewAvailableIPAddressEphemeralResource() ephemeral.EphemeralResource {
+	return &availableIPAddressEphemeralResource{}
+}
+
+func (r *availableIPAddressEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_ip_address"
+}
+
+func (r *availableIPAddressEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the next available IP address of a prefix or IP range without allocating it. Unlike the `netbox_available_ip_address` resource, nothing is created in Netbox and nothing is stored in the state, so the address can be handed to another system that records it. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"prefix_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the prefix to look up the next available IP address in. Exactly one of `prefix_id` or `ip_range_id` must be given.",
+			},
+			"ip_range_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the IP range to look up the next available IP address in. Exactly one of `prefix_id` or `ip_range_id` must be given.",
+			},
+			"ip_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The next available IP address including its mask.",
+			},
+			"vrf_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the VRF of the IP address.",
+			},
+		},
+	}
+}
+
+func (r *availableIPAddressEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.api = req.ProviderData.(*providerState)
+}
+
+func (r *availableIPAddressEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(path.MatchRoot("prefix_id"), path.MatchRoot("ip_range_id")),
+	}
+}
+
+func (r *availableIPAddressEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data availableIPAddressEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var availableIPs []*models.AvailableIP
+	var parent string
+	if !data.PrefixID.IsNull() {
+		params := ipam.NewIpamPrefixesAvailableIpsListParamsWithContext(ctx).WithID(data.PrefixID.ValueInt64())
+		res, err := r.api.Ipam.IpamPrefixesAvailableIpsList(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up available IP addresses", err.Error())
+			return
+		}
+		availableIPs = res.GetPayload()
+		parent = fmt.Sprintf("prefix %d", data.PrefixID.ValueInt64())
+	} else {
+		params := ipam.NewIpamIPRangesAvailableIpsListParamsWithContext(ctx).WithID(data.IPRangeID.ValueInt64())
+		res, err := r.api.Ipam.IpamIPRangesAvailableIpsList(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error looking up available IP addresses", err.Error())
+			return
+		}
+		availableIPs = res.GetPayload()
+		parent = fmt.Sprintf("IP range %d", data.IPRangeID.ValueInt64())
+	}
+
+	if len(availableIPs) == 0 {
+		resp.Diagnostics.AddError("No available IP address", fmt.Sprintf("There is no available IP address in %s.", parent))
+		return
+	}
+
+	data.IPAddress = types.StringValue(availableIPs[0].Address)
+	data.VrfID = types.Int64Null()
+	if availableIPs[0].Vrf != nil {
+		data.VrfID = types.Int64Value(availableIPs[0].Vrf.ID)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testOpenEphemeralResource opens an ephemeral resource with the given
+// configuration. Attributes missing from config are null.
+func testOpenEphemeralResource(t *testing.T, r ephemeral.EphemeralResource, config map[string]tftypes.Value) ephemeral.OpenResponse {
+	ctx := context.Background()
+
+	schemaResp := ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := config[name]; ok {
+			values[name] = v
+		}
+	}
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+	r.Open(ctx, req, &resp)
+	return resp
+}
+
+// testEphemeralResourceClient returns a client for a test server that answers
+// every request to path with body.
+func testEphemeralResourceClient(t *testing.T, method, path, body string) *providerState {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(ts.Close)
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	api, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &providerState{NetBoxAPI: api}
+}
+
+func TestAvailableIPAddressEphemeralResource(t *testing.T) {
+	api := testEphemeralResourceClient(t, "GET", "/api/ipam/prefixes/1/available-ips/",
+		`[{"family": 4, "address": "10.0.0.2/24", "vrf": {"id": 3, "name": "vrf"}}, {"family": 4, "address": "10.0.0.3/24"}]`)
+
+	resp := testOpenEphemeralResource(t, &availableIPAddressEphemeralResource{api: api}, map[string]tftypes.Value{
+		"prefix_id": tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var ipAddress types.String
+	var vrfID types.Int64
+	resp.Result.GetAttribute(context.Background(), path.Root("ip_address"), &ipAddress)
+	resp.Result.GetAttribute(context.Background(), path.Root("vrf_id"), &vrfID)
+	assert.Equal(t, "10.0.0.2/24", ipAddress.ValueString())
+	assert.Equal(t, int64(3), vrfID.ValueInt64())
+}
+
+func TestAvailableIPAddressEphemeralResourceExhausted(t *testing.T) {
+	api := testEphemeralResourceClient(t, "GET", "/api/ipam/ip-ranges/1/available-ips/", `[]`)
+
+	resp := testOpenEphemeralResource(t, &availableIPAddressEphemeralResource{api: api}, map[string]tftypes.Value{
+		"ip_range_id": tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
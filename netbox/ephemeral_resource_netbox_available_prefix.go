@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type availablePrefixEphemeralResource struct {
+	api *providerState
+}
+
+type availablePrefixEphemeralResourceModel struct {
+	ParentPrefixID types.Int64  `tfsdk:"parent_prefix_id"`
+	PrefixLength   types.Int64  `tfsdk:"prefix_length"`
+	Prefix         types.String `tfsdk:"prefix"`
+	VrfID          types.Int64  `tfsdk:"vrf_id"`
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &availablePrefixEphemeralResource{}
+
+// NewAvailablePrefixEphemeralResource returns the ephemeral resource
+// netbox_available_prefix.
+func NewAvailablePrefixEphemeralResource() ephemeral.EphemeralResource {
+	return &availablePrefixEphemeralResource{}
+}
+
+func (r *availablePrefixEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_prefix"
+}
+
+func (r *availablePrefixEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the next available child prefix of a given length in a parent prefix without allocating it. Unlike the `netbox_available_prefix` resource, nothing is created in Netbox and nothing is stored in the state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"parent_prefix_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the prefix to look up the next available child prefix in.",
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Length of the child prefix.",
+				Validators:          []validator.Int64{int64validator.Between(0, 128)},
+			},
+			"prefix": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The next available child prefix.",
+			},
+			"vrf_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the VRF of the prefix.",
+			},
+		},
+	}
+}
+
+func (r *availablePrefixEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.api = req.ProviderData.(*providerState)
+}
+
+func (r *availablePrefixEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data availablePrefixEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := ipam.NewIpamPrefixesAvailablePrefixesListParamsWithContext(ctx).WithID(data.ParentPrefixID.ValueInt64())
+	res, err := r.api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up available prefixes", err.Error())
+		return
+	}
+
+	prefix, vrf, err := getFirstAvailableChildPrefix(res.GetPayload(), int(data.PrefixLength.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up available prefixes", err.Error())
+		return
+	}
+	if !prefix.IsValid() {
+		resp.Diagnostics.AddError("No available prefix", fmt.Sprintf("There is no available prefix of length %d in prefix %d.", data.PrefixLength.ValueInt64(), data.ParentPrefixID.ValueInt64()))
+		return
+	}
+
+	data.Prefix = types.StringValue(prefix.String())
+	data.VrfID = types.Int64Null()
+	if vrf != nil {
+		data.VrfID = types.Int64Value(vrf.ID)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// getFirstAvailableChildPrefix returns the first prefix of the given length
+// that fits into one of the available prefixes. Netbox returns available
+// prefixes as the largest aligned blocks, so the first fitting child starts at
+// the address of the block. The returned prefix is invalid if none fits.
+func getFirstAvailableChildPrefix(available []*models.AvailablePrefix, length int) (netip.Prefix, *models.NestedVRF, error) {
+	for _, a := range available {
+		block, err := netip.ParsePrefix(a.Prefix)
+		if err != nil {
+			return netip.Prefix{}, nil, err
+		}
+		if block.Bits() <= length && length <= block.Addr().BitLen() {
+			return netip.PrefixFrom(block.Addr(), length), a.Vrf, nil
+		}
+	}
+	return netip.Prefix{}, nil, nil
+}
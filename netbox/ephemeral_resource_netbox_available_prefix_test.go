@@ -0,0 +1,56 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailablePrefixEphemeralResource(t *testing.T) {
+	api := testEphemeralResourceClient(t, "GET", "/api/ipam/prefixes/1/available-prefixes/",
+		`[{"family": 4, "prefix": "10.0.0.128/26"}, {"family": 4, "prefix": "10.0.1.0/24"}]`)
+
+	for _, tt := range []struct {
+		name     string
+		length   int
+		expected string
+	}{
+		{
+			name:     "FitsFirstBlock",
+			length:   27,
+			expected: "10.0.0.128/27",
+		},
+		{
+			name:     "FitsSecondBlock",
+			length:   25,
+			expected: "10.0.1.0/25",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testOpenEphemeralResource(t, &availablePrefixEphemeralResource{api: api}, map[string]tftypes.Value{
+				"parent_prefix_id": tftypes.NewValue(tftypes.Number, 1),
+				"prefix_length":    tftypes.NewValue(tftypes.Number, tt.length),
+			})
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var prefix types.String
+			resp.Result.GetAttribute(context.Background(), path.Root("prefix"), &prefix)
+			assert.Equal(t, tt.expected, prefix.ValueString())
+		})
+	}
+}
+
+func TestAvailablePrefixEphemeralResourceExhausted(t *testing.T) {
+	api := testEphemeralResourceClient(t, "GET", "/api/ipam/prefixes/1/available-prefixes/",
+		`[{"family": 4, "prefix": "10.0.0.128/26"}]`)
+
+	resp := testOpenEphemeralResource(t, &availablePrefixEphemeralResource{api: api}, map[string]tftypes.Value{
+		"parent_prefix_id": tftypes.NewValue(tftypes.Number, 1),
+		"prefix_length":    tftypes.NewValue(tftypes.Number, 24),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,230 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider is a terraform-plugin-framework provider that is served
+// together with the SDKv2 provider returned by Provider() via
+// terraform-plugin-mux. It hosts features the SDK does not support, like
+// provider functions, ephemeral resources and list resources, and the
+// resources already migrated from the SDKv2. It shares the API client of the
+// SDKv2 provider instead of configuring its own.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
+)
+
+// frameworkResources are the resources implemented with the framework. They
+// must not be part of the ResourcesMap of the SDKv2 provider. netbox_group is
+// a pilot for migrating the SDKv2 resources; the remaining resources still
+// have to be migrated, see "Migrating resources to the plugin framework" in
+// the README.
+var frameworkResources = map[string]func() resource.Resource{
+	"netbox_group": NewGroupResource,
+}
+
+// ProviderServer combines the SDKv2 and the framework provider into a single
+// protocol version 5 provider server. The options apply to the SDKv2
+// provider, whose client both providers share.
+func ProviderServer(ctx context.Context, opts ...ProviderOption) (func() tfprotov5.ProviderServer, error) {
+	return muxProviderServer(ctx, Provider(opts...))
+}
+
+// Serve serves the provider to Terraform with the given name until Terraform
+// stops it. Afterwards, the token the provider provisioned for username and
+// password authentication is revoked.
+func Serve(ctx context.Context, name string, opts ...tf5server.ServeOpt) error {
+	sdkProvider := Provider()
+	providerServer, err := muxProviderServer(ctx, sdkProvider)
+	if err != nil {
+		return err
+	}
+
+	err = tf5server.Serve(name, providerServer, opts...)
+	if state, ok := sdkProvider.Meta().(*providerState); ok {
+		state.revokeSessionToken()
+	}
+	return err
+}
+
+// muxProviderServer combines the given SDKv2 provider and the framework
+// provider that uses its client.
+func muxProviderServer(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	// The mux configures the providers in this order, so the SDKv2 provider
+	// is configured when the framework provider picks up its client.
+	providers := []func() tfprotov5.ProviderServer{
+		func() tfprotov5.ProviderServer {
+			return moveStateServer{sdkProvider.GRPCProvider()}
+		},
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// NewFrameworkProvider returns the terraform-plugin-framework part of the
+// provider, which uses the client of the given SDKv2 provider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "netbox"
+	resp.Version = Version
+}
+
+// Schema returns the schema of the SDKv2 provider, converted to the framework.
+// Muxed providers must have identical provider schemas.
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	sdkSchema, err := Provider().GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving provider schema", err.Error())
+		return
+	}
+
+	attributes := map[string]providerschema.Attribute{}
+	for _, a := range sdkSchema.Provider.Block.Attributes {
+		attribute, err := frameworkProviderAttribute(a)
+		if err != nil {
+			resp.Diagnostics.AddError("Error converting provider schema", err.Error())
+			return
+		}
+		attributes[a.Name] = attribute
+	}
+
+	resp.Schema = providerschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Configure hands the API client of the SDKv2 provider, which the mux
+// configured before, to the resources, ephemeral and list resources. The configuration
+// is not evaluated a second time, so the Netbox version check, its warnings
+// and session tokens only happen once.
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	if p.sdkProvider.Meta() == nil {
+		resp.Diagnostics.AddError("Error configuring provider", "The SDKv2 provider must be configured before the framework provider.")
+		return
+	}
+
+	resp.ResourceData = p.sdkProvider.Meta()
+	resp.EphemeralResourceData = p.sdkProvider.Meta()
+	resp.ListResourceData = p.sdkProvider
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAvailableIPAddressEphemeralResource,
+		NewAvailablePrefixEphemeralResource,
+		NewAPITokenEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return newObjectListResources()
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	var resources []func() resource.Resource
+	for _, newResource := range frameworkResources {
+		resources = append(resources, newResource)
+	}
+	return resources
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIDFromURLFunction,
+		NewSlugifyFunction,
+	}
+}
+
+// frameworkProviderAttribute converts a provider attribute of the SDKv2
+// provider to the framework. Only the types used in the provider block are
+// supported.
+func frameworkProviderAttribute(a *tfprotov5.SchemaAttribute) (providerschema.Attribute, error) {
+	var description, markdownDescription string
+	if a.DescriptionKind == tfprotov5.StringKindMarkdown {
+		markdownDescription = a.Description
+	} else {
+		description = a.Description
+	}
+
+	switch {
+	case a.Type.Is(tftypes.String):
+		return providerschema.StringAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+		}, nil
+	case a.Type.Is(tftypes.Bool):
+		return providerschema.BoolAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+		}, nil
+	case a.Type.Is(tftypes.Number):
+		return providerschema.Int64Attribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+		}, nil
+	case a.Type.Is(tftypes.Map{ElementType: tftypes.String}):
+		return providerschema.MapAttribute{
+			ElementType:         types.StringType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+		}, nil
+	case a.Type.Is(tftypes.Set{ElementType: tftypes.String}):
+		return providerschema.SetAttribute{
+			ElementType:         types.StringType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s of provider attribute %s", a.Type, a.Name)
+}
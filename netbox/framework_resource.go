@@ -0,0 +1,129 @@
+package netbox
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources that are implemented with terraform-plugin-framework instead of
+// the SDKv2 keep the attributes and behavior of the SDKv2 resources: the
+// helpers below provide what the wrappers in Provider() add to SDKv2
+// resources, like the object metadata, the branch attribute and the
+// diagnostics for Netbox error responses.
+
+// frameworkObjectMetadataAttributes adds the computed attributes of
+// withObjectMetadata to the given framework attributes.
+func frameworkObjectMetadataAttributes(attributes map[string]resourceschema.Attribute) map[string]resourceschema.Attribute {
+	for key, s := range withObjectMetadata(map[string]*schema.Schema{}) {
+		attribute := resourceschema.StringAttribute{
+			Computed:    true,
+			Description: s.Description,
+		}
+		// The URL and the creation time never change
+		if key == "url" || key == "created" {
+			attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		}
+		attributes[key] = attribute
+	}
+	return attributes
+}
+
+// frameworkIDAttribute is the id attribute of framework resources, which
+// holds the ID of the Netbox object.
+var frameworkIDAttribute = resourceschema.StringAttribute{
+	Computed:      true,
+	Description:   "The ID of this resource.",
+	PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+}
+
+// frameworkBranchAttribute is the branch attribute that wrapBranch adds to
+// SDKv2 resources.
+var frameworkBranchAttribute = resourceschema.StringAttribute{
+	Optional:            true,
+	MarkdownDescription: branchDescription,
+}
+
+// frameworkMetadataValue converts a value returned by getObjectMetadata.
+func frameworkMetadataValue(metadata map[string]interface{}, key string) types.String {
+	value, ok := metadata[key].(string)
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// frameworkBranchState returns the state of the provider for the branch of a
+// framework resource, see forBranch.
+func frameworkBranchState(api *providerState, branch types.String) (*providerState, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	if api == nil {
+		diags.AddError("Provider not configured", "The provider must be configured before resources can be managed.")
+		return nil, diags
+	}
+	state, err := api.forBranch(branch.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(branchKey), "Error looking up branch", err.Error())
+		return nil, diags
+	}
+	return state, diags
+}
+
+// frameworkDiagnostics converts the diagnostics of requests to Netbox to
+// framework diagnostics. Like for SDKv2 resources, error responses are split
+// into one diagnostic per rejected field, which is attributed to the given
+// attribute of the same name.
+func frameworkDiagnostics(diags diag.Diagnostics, attributes ...string) fwdiag.Diagnostics {
+	resourceSchema := map[string]*schema.Schema{}
+	for _, attribute := range attributes {
+		resourceSchema[attribute] = &schema.Schema{}
+	}
+
+	var result fwdiag.Diagnostics
+	for _, diagnostic := range diags {
+		for _, d := range apiErrorDiagnostics(resourceSchema, diagnostic) {
+			var attribute string
+			if len(d.AttributePath) > 0 {
+				if step, ok := d.AttributePath[0].(cty.GetAttrStep); ok {
+					attribute = step.Name
+				}
+			}
+			switch {
+			case d.Severity == diag.Warning && attribute != "":
+				result.AddAttributeWarning(path.Root(attribute), d.Summary, d.Detail)
+			case d.Severity == diag.Warning:
+				result.AddWarning(d.Summary, d.Detail)
+			case attribute != "":
+				result.AddAttributeError(path.Root(attribute), d.Summary, d.Detail)
+			default:
+				result.AddError(d.Summary, d.Detail)
+			}
+		}
+	}
+	return result
+}
+
+// sdkStateString returns the value of a string attribute in a state written
+// by the SDKv2, decoded from JSON. The SDKv2 stores unset strings as "",
+// which the framework represents as null, so "" and missing attributes
+// become null. Otherwise, moving a resource to the framework would plan to
+// change every unset attribute from "" to null.
+//
+// The state does not tell unset attributes from attributes that are set to ""
+// in the configuration. For an optional attribute that is not computed and
+// explicitly set to "", like description = "", the first plan after the
+// upgrade therefore shows an in-place update from null to "". Computed
+// attributes are read from Netbox again on the next refresh.
+func sdkStateString(state map[string]interface{}, key string) types.String {
+	value, _ := state[key].(string)
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
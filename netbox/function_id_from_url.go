@@ -0,0 +1,73 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type idFromURLFunction struct{}
+
+var _ function.Function = &idFromURLFunction{}
+
+// NewIDFromURLFunction returns the provider function id_from_url.
+func NewIDFromURLFunction() function.Function {
+	return &idFromURLFunction{}
+}
+
+func (f *idFromURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "id_from_url"
+}
+
+func (f *idFromURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the object ID from a Netbox URL",
+		MarkdownDescription: "Returns the numeric ID of the object a Netbox API or web UI URL points to, e.g. `42` for `https://netbox.example.com/api/dcim/devices/42/` or `https://netbox.example.com/dcim/devices/42/interfaces/`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The Netbox API or web UI URL.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *idFromURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawURL string
+
+	resp.Error = req.Arguments.Get(ctx, &rawURL)
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := getIDFromURL(rawURL)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, id)
+}
+
+// getIDFromURL returns the last numeric path segment of a Netbox URL, which is
+// the ID of the object the URL points to.
+func getIDFromURL(rawURL string) (int64, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid URL %q: %s", rawURL, err)
+	}
+
+	segments := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if id, err := strconv.ParseInt(segments[i], 10, 64); err == nil {
+			return id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("URL %q does not contain an object ID", rawURL)
+}
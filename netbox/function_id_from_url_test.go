@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIDFromURL(t *testing.T) {
+	for _, tt := range []struct {
+		name, input string
+		expected    int64
+	}{
+		{
+			name:     "API",
+			input:    "https://netbox.example.com/api/dcim/devices/42/",
+			expected: 42,
+		},
+		{
+			name:     "UI",
+			input:    "https://netbox.example.com/ipam/prefixes/7",
+			expected: 7,
+		},
+		{
+			name:     "UISubpage",
+			input:    "https://netbox.example.com/dcim/devices/42/interfaces/?tab=all",
+			expected: 42,
+		},
+		{
+			name:     "PathPrefix",
+			input:    "https://example.com/netbox/api/ipam/ip-addresses/1337/",
+			expected: 1337,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := getIDFromURL(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, id)
+		})
+	}
+}
+
+func TestGetIDFromURLWithoutID(t *testing.T) {
+	_, err := getIDFromURL("https://netbox.example.com/api/dcim/devices/")
+	assert.Error(t, err)
+}
+
+func TestIDFromURLFunction(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://netbox.example.com/api/dcim/sites/3/")}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.Int64Unknown()),
+	}
+
+	NewIDFromURLFunction().Run(context.Background(), req, &resp)
+
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.Int64Value(3), resp.Result.Value())
+}
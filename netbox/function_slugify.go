@@ -0,0 +1,45 @@
+package netbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type slugifyFunction struct{}
+
+var _ function.Function = &slugifyFunction{}
+
+// NewSlugifyFunction returns the provider function slugify.
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+func (f *slugifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Generate a slug from a name",
+		MarkdownDescription: "Generates a slug from a name the same way the provider does for resources with an optional `slug` attribute, following the rules of Netbox: special characters, including non-ASCII letters, are removed, whitespace, dots and dashes are collapsed into a single dash, leading and trailing dashes are trimmed and the result is lowercased and truncated to 100 characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to generate the slug from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, getSlug(name))
+}
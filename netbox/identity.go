@@ -0,0 +1,215 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// identityKey is an attribute of a resource identity that, together with the
+// other keys of the identity, identifies the object in Netbox independently
+// of its ID.
+type identityKey struct {
+	// name is the name of the identity attribute and the API field and filter
+	// it corresponds to.
+	name string
+	// attribute is the resource attribute that holds the value. It is empty
+	// for keys that reference another object.
+	attribute string
+	// reference is the key in references of the object that the key
+	// references, e.g. the site of a device. The value of the key is the
+	// name or slug of the referenced object, which is read from the
+	// `<reference>_id` attribute.
+	reference string
+}
+
+// resourceIdentities are the resources that have a resource identity, with
+// the keys of their identity besides the ID of the object. The keys are also
+// used to adopt existing objects, see wrapAdoptExisting. Resources in
+// listResources need an identity.
+var resourceIdentities = map[string][]identityKey{
+	"netbox_circuit_type":        {{name: "slug", attribute: "slug"}},
+	"netbox_cluster_type":        {{name: "slug", attribute: "slug"}},
+	"netbox_contact_role":        {{name: "slug", attribute: "slug"}},
+	"netbox_device":              {{name: "name", attribute: "name"}, {name: "site", reference: "site"}},
+	"netbox_device_role":         {{name: "slug", attribute: "slug"}},
+	"netbox_device_type":         {{name: "slug", attribute: "slug"}, {name: "manufacturer", reference: "manufacturer"}},
+	"netbox_inventory_item_role": {{name: "slug", attribute: "slug"}},
+	"netbox_ip_address":          {{name: "address", attribute: "ip_address"}, {name: "vrf", reference: "vrf"}},
+	"netbox_ipam_role":           {{name: "slug", attribute: "slug"}},
+	"netbox_manufacturer":        {{name: "slug", attribute: "slug"}},
+	"netbox_platform":            {{name: "slug", attribute: "slug"}},
+	"netbox_prefix":              {{name: "prefix", attribute: "prefix"}, {name: "vrf", reference: "vrf"}},
+	"netbox_rack_role":           {{name: "slug", attribute: "slug"}},
+	"netbox_rir":                 {{name: "slug", attribute: "slug"}},
+	"netbox_site":                {{name: "slug", attribute: "slug"}},
+	"netbox_tag":                 {{name: "slug", attribute: "slug"}},
+	"netbox_tenant":              {{name: "slug", attribute: "slug"}},
+	"netbox_virtual_machine":     {{name: "name", attribute: "name"}, {name: "cluster", reference: "cluster"}},
+}
+
+// wrapResourceIdentity adds a resource identity to the given resource, which
+// consists of the ID of its Netbox object and the given keys. The identity
+// is set whenever the object is created, read or updated. `import` blocks
+// can use either the ID or all keys of the identity, which still match when
+// the objects are recreated with new IDs, e.g. in a rebuilt environment.
+func wrapResourceIdentity(r *schema.Resource, path string, keys []identityKey) {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := map[string]*schema.Schema{
+				"id": {
+					Type:              schema.TypeInt,
+					OptionalForImport: true,
+					Description:       "The ID of the object in Netbox. Takes precedence over the other attributes.",
+				},
+			}
+			for _, key := range keys {
+				description := fmt.Sprintf("The %s of the object.", strings.ReplaceAll(key.name, "_", " "))
+				if key.reference != "" {
+					ref := references[key.reference]
+					description = fmt.Sprintf("The %s of the %s of the object, empty if it has none.", ref.lookupFields(), ref.label)
+				}
+				identitySchema[key.name] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       description,
+				}
+			}
+			return identitySchema
+		},
+	}
+	// Keys like the name of a device change with the object.
+	r.ResourceBehavior.MutableIdentity = true
+
+	r.CreateContext = withIdentity(r.CreateContext, keys)
+	r.ReadContext = withIdentity(r.ReadContext, keys)
+	r.UpdateContext = withIdentity(r.UpdateContext, keys)
+
+	if r.Importer == nil || r.Importer.StateContext == nil {
+		return
+	}
+	importState := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			id, err := getIDByIdentity(m, path, keys, identity)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(strconv.FormatInt(id, 10))
+		}
+		return importState(ctx, d, m)
+	}
+}
+
+// withIdentity sets the identity of the resource from its state after f
+// succeeded.
+func withIdentity(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, keys []identityKey) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return append(diags, diag.Errorf("the ID %q is not numeric and can not be used as identity", d.Id())...)
+		}
+		identity, err := d.Identity()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := identity.Set("id", id); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		for _, key := range keys {
+			value, err := getIdentityKeyValue(m, key, d)
+			if err == nil {
+				err = identity.Set(key.name, value)
+			}
+			if err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+}
+
+// getIdentityKeyValue returns the value of the identity key from the state
+// of the resource. Referenced objects are looked up by their ID.
+func getIdentityKeyValue(m interface{}, key identityKey, d *schema.ResourceData) (string, error) {
+	if key.reference == "" {
+		return d.Get(key.attribute).(string), nil
+	}
+
+	id := d.Get(key.reference + "_id").(int)
+	if id == 0 {
+		return "", nil
+	}
+	api, ok := m.(*providerState)
+	if !ok {
+		return "", fmt.Errorf("the %s of the identity can not be looked up without a connection to Netbox", key.name)
+	}
+	return lookupReferenceName(api, references[key.reference], int64(id))
+}
+
+// getIdentityKeyValueFromObject returns the value of the identity key from
+// an object returned by the Netbox API, like getIdentityKeyValue does from
+// the state.
+func getIdentityKeyValueFromObject(key identityKey, object map[string]interface{}) string {
+	if key.reference == "" {
+		value, _ := object[key.name].(string)
+		return value
+	}
+
+	nested, _ := object[key.name].(map[string]interface{})
+	value, _ := nested[references[key.reference].filters[0]].(string)
+	return value
+}
+
+// getIDByIdentity returns the ID of the object with the given identity. If
+// the identity has no ID, the object is looked up by all keys.
+func getIDByIdentity(m interface{}, path string, keys []identityKey, identity *schema.IdentityData) (int64, error) {
+	if id, ok := identity.GetOk("id"); ok {
+		return int64(id.(int)), nil
+	}
+
+	api, ok := m.(*providerState)
+	if !ok {
+		return 0, fmt.Errorf("the object can not be looked up by its identity without a connection to Netbox")
+	}
+
+	query := url.Values{}
+	var filters []string
+	for _, key := range keys {
+		value := identity.Get(key.name).(string)
+		filters = append(filters, key.name+"="+value)
+		switch {
+		case key.reference == "":
+			if value == "" {
+				return 0, fmt.Errorf("the identity of the object needs either the id or the %s", key.name)
+			}
+			query.Set(key.name, value)
+		case value == "":
+			query.Set(key.reference+"_id", "null")
+		default:
+			id, err := lookupReference(api, references[key.reference], value)
+			if err != nil {
+				return 0, err
+			}
+			query.Set(key.reference+"_id", strconv.FormatInt(id, 10))
+		}
+	}
+	return getIDByQuery(api, path, query, strings.Join(filters, ","))
+}
@@ -0,0 +1,116 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapResourceIdentity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/dcim/sites/17/":
+			w.Write([]byte(`{"id": 17, "name": "Frankfurt 1", "slug": "frankfurt-1"}`))
+		case r.URL.Path == "/api/dcim/sites/" && r.URL.Query().Get("slug") == "frankfurt-1":
+			w.Write([]byte(`{"count": 1, "results": [{"id": 17}]}`))
+		case r.URL.Path == "/api/dcim/devices/":
+			assert.Equal(t, "sw01", r.URL.Query().Get("name"))
+			assert.Equal(t, "17", r.URL.Query().Get("site_id"))
+			w.Write([]byte(`{"count": 1, "results": [{"id": 12}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			d.Set("name", "sw01")
+			return d.Set("site_id", 17)
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapResourceIdentity(r, "/dcim/devices/", resourceIdentities["netbox_device"])
+
+	t.Run("Read", func(t *testing.T) {
+		d := r.Data(nil)
+		d.SetId("12")
+		assert.Empty(t, r.ReadContext(context.Background(), d, api))
+		identity, err := d.Identity()
+		assert.NoError(t, err)
+		assert.Equal(t, 12, identity.Get("id"))
+		assert.Equal(t, "sw01", identity.Get("name"))
+		assert.Equal(t, "frankfurt-1", identity.Get("site"))
+	})
+
+	t.Run("ReadNonNumericID", func(t *testing.T) {
+		d := r.Data(nil)
+		d.SetId("sw01")
+		assert.True(t, r.ReadContext(context.Background(), d, api).HasError())
+	})
+
+	t.Run("ImportByID", func(t *testing.T) {
+		d := r.Data(nil)
+		identity, err := d.Identity()
+		assert.NoError(t, err)
+		assert.NoError(t, identity.Set("id", 7))
+		imported, err := r.Importer.StateContext(context.Background(), d, api)
+		assert.NoError(t, err)
+		assert.Equal(t, "7", imported[0].Id())
+	})
+
+	t.Run("ImportByKeys", func(t *testing.T) {
+		d := r.Data(nil)
+		identity, err := d.Identity()
+		assert.NoError(t, err)
+		assert.NoError(t, identity.Set("name", "sw01"))
+		assert.NoError(t, identity.Set("site", "frankfurt-1"))
+		imported, err := r.Importer.StateContext(context.Background(), d, api)
+		assert.NoError(t, err)
+		assert.Equal(t, "12", imported[0].Id())
+	})
+
+	t.Run("ImportWithoutKeys", func(t *testing.T) {
+		d := r.Data(nil)
+		_, err := r.Importer.StateContext(context.Background(), d, api)
+		assert.ErrorContains(t, err, "needs either the id or the name")
+	})
+}
+
+func TestGetIdentityKeyValueFromObject(t *testing.T) {
+	object := map[string]interface{}{
+		"address": "10.0.0.1/24",
+		"vrf":     map[string]interface{}{"id": 3, "name": "prod"},
+	}
+	assert.Equal(t, "10.0.0.1/24", getIdentityKeyValueFromObject(identityKey{name: "address", attribute: "ip_address"}, object))
+	assert.Equal(t, "prod", getIdentityKeyValueFromObject(identityKey{name: "vrf", reference: "vrf"}, object))
+	assert.Equal(t, "", getIdentityKeyValueFromObject(identityKey{name: "vrf", reference: "vrf"}, map[string]interface{}{"vrf": nil}))
+}
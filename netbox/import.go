@@ -0,0 +1,270 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importPaths are the API endpoints of the objects that the import IDs of the
+// resources refer to. These resources can also be imported by filter, e.g.
+// "slug=frankfurt-1", instead of by ID.
+var importPaths = map[string]string{
+	"netbox_aggregate":                    "/ipam/aggregates/",
+	"netbox_asn":                          "/ipam/asns/",
+	"netbox_available_ip_address":         "/ipam/ip-addresses/",
+	"netbox_available_prefix":             "/ipam/prefixes/",
+	"netbox_cable":                        "/dcim/cables/",
+	"netbox_circuit":                      "/circuits/circuits/",
+	"netbox_circuit_provider":             "/circuits/providers/",
+	"netbox_circuit_termination":          "/circuits/circuit-terminations/",
+	"netbox_circuit_type":                 "/circuits/circuit-types/",
+	"netbox_cluster":                      "/virtualization/clusters/",
+	"netbox_cluster_group":                "/virtualization/cluster-groups/",
+	"netbox_cluster_type":                 "/virtualization/cluster-types/",
+	"netbox_config_context":               "/extras/config-contexts/",
+	"netbox_config_template":              "/extras/config-templates/",
+	"netbox_console_port_template":        "/dcim/console-port-templates/",
+	"netbox_console_server_port_template": "/dcim/console-server-port-templates/",
+	"netbox_contact":                      "/tenancy/contacts/",
+	"netbox_contact_assignment":           "/tenancy/contact-assignments/",
+	"netbox_contact_group":                "/tenancy/contact-groups/",
+	"netbox_contact_role":                 "/tenancy/contact-roles/",
+	"netbox_custom_field":                 "/extras/custom-fields/",
+	"netbox_custom_field_choice_set":      "/extras/custom-field-choice-sets/",
+	"netbox_device":                       "/dcim/devices/",
+	"netbox_device_bay":                   "/dcim/device-bays/",
+	"netbox_device_bay_template":          "/dcim/device-bay-templates/",
+	"netbox_device_console_port":          "/dcim/console-ports/",
+	"netbox_device_console_server_port":   "/dcim/console-server-ports/",
+	"netbox_device_front_port":            "/dcim/front-ports/",
+	"netbox_device_interface":             "/dcim/interfaces/",
+	"netbox_device_module_bay":            "/dcim/module-bays/",
+	"netbox_device_power_outlet":          "/dcim/power-outlets/",
+	"netbox_device_power_port":            "/dcim/power-ports/",
+	"netbox_device_primary_ip":            "/dcim/devices/",
+	"netbox_device_rear_port":             "/dcim/rear-ports/",
+	"netbox_device_role":                  "/dcim/device-roles/",
+	"netbox_device_type":                  "/dcim/device-types/",
+	"netbox_device_type_import":           "/dcim/device-types/",
+	"netbox_event_rule":                   "/extras/event-rules/",
+	"netbox_front_port_template":          "/dcim/front-port-templates/",
+	"netbox_group":                        "/users/groups/",
+	"netbox_interface":                    "/virtualization/interfaces/",
+	"netbox_interface_template":           "/dcim/interface-templates/",
+	"netbox_inventory_item":               "/dcim/inventory-items/",
+	"netbox_inventory_item_role":          "/dcim/inventory-item-roles/",
+	"netbox_inventory_item_template":      "/dcim/inventory-item-templates/",
+	"netbox_ip_address":                   "/ipam/ip-addresses/",
+	"netbox_ip_address_set":               "/ipam/ip-addresses/",
+	"netbox_ip_range":                     "/ipam/ip-ranges/",
+	"netbox_ipam_role":                    "/ipam/roles/",
+	"netbox_location":                     "/dcim/locations/",
+	"netbox_mac_address":                  "/dcim/mac-addresses/",
+	"netbox_manufacturer":                 "/dcim/manufacturers/",
+	"netbox_module":                       "/dcim/modules/",
+	"netbox_module_bay_template":          "/dcim/module-bay-templates/",
+	"netbox_module_type":                  "/dcim/module-types/",
+	"netbox_permission":                   "/users/permissions/",
+	"netbox_platform":                     "/dcim/platforms/",
+	"netbox_power_feed":                   "/dcim/power-feeds/",
+	"netbox_power_outlet_template":        "/dcim/power-outlet-templates/",
+	"netbox_power_panel":                  "/dcim/power-panels/",
+	"netbox_power_port_template":          "/dcim/power-port-templates/",
+	"netbox_prefix":                       "/ipam/prefixes/",
+	"netbox_primary_ip":                   "/virtualization/virtual-machines/",
+	"netbox_rack":                         "/dcim/racks/",
+	"netbox_rack_reservation":             "/dcim/rack-reservations/",
+	"netbox_rack_role":                    "/dcim/rack-roles/",
+	"netbox_rack_type":                    "/dcim/rack-types/",
+	"netbox_rear_port_template":           "/dcim/rear-port-templates/",
+	"netbox_region":                       "/dcim/regions/",
+	"netbox_rir":                          "/ipam/rirs/",
+	"netbox_route_target":                 "/ipam/route-targets/",
+	"netbox_service":                      "/ipam/services/",
+	"netbox_site":                         "/dcim/sites/",
+	"netbox_site_group":                   "/dcim/site-groups/",
+	"netbox_tag":                          "/extras/tags/",
+	"netbox_tag_assignment":               "/extras/tags/",
+	"netbox_tenant":                       "/tenancy/tenants/",
+	"netbox_tenant_group":                 "/tenancy/tenant-groups/",
+	"netbox_token":                        "/users/tokens/",
+	"netbox_user":                         "/users/users/",
+	"netbox_virtual_chassis":              "/dcim/virtual-chassis/",
+	"netbox_virtual_device_context":       "/dcim/virtual-device-contexts/",
+	"netbox_virtual_disk":                 "/virtualization/virtual-disks/",
+	"netbox_virtual_machine":              "/virtualization/virtual-machines/",
+	"netbox_vlan":                         "/ipam/vlans/",
+	"netbox_vlan_group":                   "/ipam/vlan-groups/",
+	"netbox_vpn_tunnel":                   "/vpn/tunnels/",
+	"netbox_vpn_tunnel_group":             "/vpn/tunnel-groups/",
+	"netbox_vpn_tunnel_termination":       "/vpn/tunnel-terminations/",
+	"netbox_vrf":                          "/ipam/vrfs/",
+	"netbox_webhook":                      "/extras/webhooks/",
+}
+
+// compositeImportIDs are the resources in importPaths whose import ID
+// consists of more than the ID of the object. Their importers resolve the
+// filters in their import ID with importIDByFilter themselves.
+var compositeImportIDs = []string{
+	"netbox_available_prefix",
+	"netbox_device_primary_ip",
+	"netbox_ip_address_set",
+	"netbox_primary_ip",
+	"netbox_tag_assignment",
+}
+
+// wrapImportByFilter lets the given resource also be imported by filters of
+// the Netbox API, like "slug=frankfurt-1" or "name=sw01,site=frankfurt-1",
+// in addition to the numeric ID. The filters must match exactly one object.
+func wrapImportByFilter(r *schema.Resource, path string) {
+	if r.Importer == nil || r.Importer.StateContext == nil {
+		return
+	}
+
+	importState := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		id, err := importIDByFilter(m, path, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		return importState(ctx, d, m)
+	}
+}
+
+// importIDByFilter returns the ID of the only object at the given API path
+// that matches the filters in importID. An importID without filters is
+// returned as it is.
+func importIDByFilter(m interface{}, path, importID string) (string, error) {
+	if !strings.Contains(importID, "=") {
+		return importID, nil
+	}
+	api, ok := m.(*providerState)
+	if !ok {
+		return "", fmt.Errorf("%s can not be imported without a connection to Netbox", importID)
+	}
+	id, err := getIDByFilter(api, path, importID)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// parseFilters parses filters, given as comma separated key=value pairs.
+func parseFilters(filters string) (url.Values, error) {
+	query := url.Values{}
+	for _, filter := range strings.Split(filters, ",") {
+		key, value, ok := strings.Cut(filter, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("unexpected format of (%s), expected 'key=value' pairs separated by commas", filters)
+		}
+		query.Add(key, value)
+	}
+	return query, nil
+}
+
+// getIDByFilter returns the ID of the only object at the given API path that
+// matches the filters, given as comma separated key=value pairs.
+func getIDByFilter(api *providerState, path, filters string) (int64, error) {
+	query, err := parseFilters(filters)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkFilterKeys(api, path, query); err != nil {
+		return 0, err
+	}
+	return getIDByQuery(api, path, query, filters)
+}
+
+// getIDsByFilter returns the IDs of all objects at the given API path that
+// match the filters, given as comma separated key=value pairs.
+func getIDsByFilter(api *providerState, path, filters string) ([]int64, error) {
+	query, err := parseFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFilterKeys(api, path, query); err != nil {
+		return nil, err
+	}
+	results, err := apiList(api, path, query, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(results))
+	for _, result := range results {
+		id, _ := result.(map[string]interface{})["id"].(json.Number)
+		objectID, err := id.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("object matching %s has no valid ID: %s", filters, err)
+		}
+		ids = append(ids, objectID)
+	}
+	return ids, nil
+}
+
+// filterCheckValue is a value that no object matches, used by
+// checkFilterKeys.
+const filterCheckValue = "terraform-provider-netbox-filter-check"
+
+// checkFilterKeys returns an error if one of the keys of the query is not a
+// filter of the given API path. Netbox ignores unknown filters, so a
+// misspelled key would otherwise match all objects. Netbox rejects a value
+// that no object can match with a 400, or returns no objects for it, unless
+// it ignores the filter.
+func checkFilterKeys(api *providerState, path string, query url.Values) error {
+	for key := range query {
+		res, err := apiRequest(api, "GET", path, url.Values{key: {filterCheckValue}, "limit": {"1"}}, nil)
+		if err != nil {
+			var requestErr *apiRequestError
+			if errors.As(err, &requestErr) && requestErr.statusCode == http.StatusBadRequest {
+				continue
+			}
+			return err
+		}
+		if results, _ := res.(map[string]interface{})["results"].([]interface{}); len(results) > 0 {
+			return fmt.Errorf("%s is not a filter of %s", key, path)
+		}
+	}
+	return nil
+}
+
+// errNoObjectFound is returned by getIDByQuery if no object matches.
+var errNoObjectFound = errors.New("no object found")
+
+// getIDByQuery returns the ID of the only object at the given API path that
+// matches the query. filters describes the query in error messages.
+func getIDByQuery(api *providerState, path string, query url.Values, filters string) (int64, error) {
+	query.Set("limit", "2")
+	res, err := apiRequest(api, "GET", path, query, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	results, _ := res.(map[string]interface{})["results"].([]interface{})
+	switch len(results) {
+	case 0:
+		return 0, fmt.Errorf("%w matching %s", errNoObjectFound, filters)
+	case 1:
+	default:
+		return 0, fmt.Errorf("more than one object matches %s, specify more filters", filters)
+	}
+
+	id, ok := results[0].(map[string]interface{})["id"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("object matching %s has no ID", filters)
+	}
+	objectID, err := id.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("object matching %s has no valid ID: %s", filters, err)
+	}
+	return objectID, nil
+}
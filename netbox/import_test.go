@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportByFilter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/dcim/sites/", r.URL.Path)
+		switch r.URL.Query().Get("slug") {
+		case "frankfurt-1":
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			w.Write([]byte(`{"count": 1, "results": [{"id": 17, "slug": "frankfurt-1"}]}`))
+		case "":
+			w.Write([]byte(`{"count": 2, "results": [{"id": 17}, {"id": 18}]}`))
+		default:
+			w.Write([]byte(`{"count": 0, "results": []}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	r := resourceNetboxSite()
+	wrapImportByFilter(r, importPaths["netbox_site"])
+
+	for importID, expected := range map[string]string{
+		"17":                 "17",
+		"slug=frankfurt-1":   "17",
+		"slug=berlin-1":      "",
+		"name=Frankfurt":     "",
+		"slug=frankfurt-1,=": "",
+	} {
+		d := r.TestResourceData()
+		d.SetId(importID)
+
+		result, err := r.Importer.StateContext(context.Background(), d, api)
+		if expected == "" {
+			assert.Error(t, err, importID)
+			continue
+		}
+		assert.NoError(t, err, importID)
+		assert.Equal(t, expected, result[0].Id(), importID)
+	}
+}
+
+func TestImportPathsCoverResources(t *testing.T) {
+	provider := Provider()
+	for name := range importPaths {
+		if _, ok := frameworkResources[name]; ok {
+			continue
+		}
+		r, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Errorf("import path for unknown resource %s", name)
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("resource %s has no importer", name)
+		}
+	}
+}
+
+func TestImportByFilterChecksKeys(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		switch {
+		case query.Get("tenant") == filterCheckValue:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"tenant": ["Select a valid choice."]}`))
+		case query.Get("name") == filterCheckValue:
+			w.Write([]byte(`{"count": 0, "results": []}`))
+		default:
+			// Netbox ignores unknown filters like "nmae"
+			w.Write([]byte(`{"count": 1, "results": [{"id": 42}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	for filters, expected := range map[string]string{
+		"42":                      "42",
+		"name=vm01":               "42",
+		"name=vm01,tenant=team-a": "42",
+		"nmae=vm01":               "",
+		"name=vm01,tennat=team-a": "",
+	} {
+		id, err := importIDByFilter(api, importPaths["netbox_virtual_machine"], filters)
+		if expected == "" {
+			assert.ErrorContains(t, err, "is not a filter", filters)
+			continue
+		}
+		assert.NoError(t, err, filters)
+		assert.Equal(t, expected, id, filters)
+	}
+
+	r := resourceNetboxPrimaryIP()
+	for importID, expected := range map[string]string{
+		"name=vm01":   "42 4",
+		"name=vm01 6": "42 6",
+		"42 6":        "42 6",
+		"name=vm 01":  "42 4",
+		"vm01":        "",
+		"42 5":        "",
+	} {
+		d := r.TestResourceData()
+		d.SetId(importID)
+
+		result, err := r.Importer.StateContext(context.Background(), d, api)
+		if expected == "" {
+			assert.Error(t, err, importID)
+			continue
+		}
+		assert.NoError(t, err, importID)
+		assert.Equal(t, expected, fmt.Sprintf("%s %d", result[0].Id(), result[0].Get("ip_address_version")), importID)
+	}
+}
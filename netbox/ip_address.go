@@ -0,0 +1,34 @@
+package netbox
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeIPAddress converts the given IP address or prefix in CIDR
+// notation to its canonical form, e.g. `2001:db8::1/64` for
+// `2001:DB8:0::0001/64`. The host bits of prefixes are kept. Invalid values
+// are returned as is.
+func normalizeIPAddress(address string) string {
+	if strings.Contains(address, "/") {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return address
+		}
+		return prefix.String()
+	}
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+	return addr.String()
+}
+
+// diffSuppressIPAddress suppresses diffs between different notations of the
+// same IP address or prefix, because Netbox always returns the canonical
+// notation.
+func diffSuppressIPAddress(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeIPAddress(old) == normalizeIPAddress(new)
+}
@@ -0,0 +1,21 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeIPAddress(t *testing.T) {
+	for input, expected := range map[string]string{
+		"10.0.0.1/24":           "10.0.0.1/24",
+		"2001:DB8::1/64":        "2001:db8::1/64",
+		"2001:db8:0:0::0001/64": "2001:db8::1/64",
+		"2001:db8::/32":         "2001:db8::/32",
+		"::0/0":                 "::/0",
+		"2001:DB8::1":           "2001:db8::1",
+		"invalid":               "invalid",
+	} {
+		assert.Equal(t, expected, normalizeIPAddress(input), input)
+	}
+}
@@ -0,0 +1,203 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listResources are the resources whose objects can be listed with
+// `terraform query`, e.g. to generate import blocks for unmanaged objects.
+// They need a resource identity, see resourceIdentities.
+var listResources = []string{
+	"netbox_device",
+	"netbox_ip_address",
+	"netbox_prefix",
+	"netbox_site",
+	"netbox_virtual_machine",
+}
+
+// objectListResource lists the objects of an SDKv2 resource. The resource
+// attributes of the results are read by the SDKv2 provider, like on a
+// refresh after an import.
+type objectListResource struct {
+	typeName string
+	provider *schema.Provider
+}
+
+type objectListResourceModel struct {
+	Filters types.Map `tfsdk:"filters"`
+}
+
+var (
+	_ list.ListResourceWithConfigure    = &objectListResource{}
+	_ list.ListResourceWithRawV5Schemas = &objectListResource{}
+)
+
+// newObjectListResources returns a list resource for every resource in
+// listResources.
+func newObjectListResources() []func() list.ListResource {
+	var listResourceFuncs []func() list.ListResource
+	for _, typeName := range listResources {
+		listResourceFuncs = append(listResourceFuncs, func() list.ListResource {
+			return &objectListResource{typeName: typeName}
+		})
+	}
+	return listResourceFuncs
+}
+
+func (r *objectListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *objectListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the objects of `%s` in Netbox. Requires Terraform 1.14 or later.", r.typeName),
+		Attributes: map[string]listschema.Attribute{
+			"filters": listschema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Filters of the Netbox API, e.g. `{ site = \"frankfurt-1\" }`. By default, all objects are listed.",
+			},
+		},
+	}
+}
+
+// RawV5Schemas returns the schemas of the SDKv2 resource, because list
+// resources need the schemas of the resource they list.
+func (r *objectListResource) RawV5Schemas(ctx context.Context, req list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	server := Provider().GRPCProvider()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return
+	}
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return
+	}
+
+	resp.ProtoV5Schema = schemas.ResourceSchemas[r.typeName]
+	resp.ProtoV5IdentitySchema = identitySchemas.IdentitySchemas[r.typeName]
+}
+
+func (r *objectListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.provider = req.ProviderData.(*schema.Provider)
+}
+
+func (r *objectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data objectListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var filters map[string]string
+	diags.Append(data.Filters.ElementsAs(ctx, &filters, false)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	query := url.Values{}
+	for key, value := range filters {
+		query.Set(key, value)
+	}
+
+	objects, err := apiList(r.provider.Meta().(*providerState), importPaths[r.typeName], query, int(req.Limit), 0)
+	if err != nil {
+		diags.AddError("Error listing objects", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, object := range objects {
+			if !push(r.listResult(ctx, req, object)) {
+				return
+			}
+		}
+	}
+}
+
+// listResult returns the list result of an object returned by the Netbox
+// API.
+func (r *objectListResource) listResult(ctx context.Context, req list.ListRequest, object interface{}) list.ListResult {
+	result := req.NewListResult(ctx)
+
+	fields, _ := object.(map[string]interface{})
+	id, err := fields["id"].(json.Number).Int64()
+	if err != nil {
+		result.Diagnostics.AddError("Error listing objects", fmt.Sprintf("the object %v has no valid ID", object))
+		return result
+	}
+	result.DisplayName, _ = fields["display"].(string)
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), id)...)
+	for _, key := range resourceIdentities[r.typeName] {
+		value := getIdentityKeyValueFromObject(key, fields)
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(key.name), value)...)
+	}
+
+	if req.IncludeResource && !result.Diagnostics.HasError() {
+		state, err := r.readResource(ctx, req.ResourceSchema.Type().TerraformType(ctx), id)
+		if err != nil {
+			result.Diagnostics.AddError("Error reading object", err.Error())
+			return result
+		}
+		result.Resource.Raw = state
+	}
+
+	return result
+}
+
+// readResource reads the object with the given ID through the SDKv2
+// provider and returns the state of its resource.
+func (r *objectListResource) readResource(ctx context.Context, typ tftypes.Type, id int64) (tftypes.Value, error) {
+	attributes := make(map[string]tftypes.Value)
+	for name, attributeType := range typ.(tftypes.Object).AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["id"] = tftypes.NewValue(tftypes.String, strconv.FormatInt(id, 10))
+
+	currentState, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	resp, err := r.provider.GRPCProvider().ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     r.typeName,
+		CurrentState: &currentState,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return tftypes.Value{}, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	state, err := resp.NewState.Unmarshal(typ)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if state.IsNull() {
+		return tftypes.Value{}, fmt.Errorf("the object with ID %d no longer exists", id)
+	}
+	return state, nil
+}
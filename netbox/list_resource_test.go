@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectListResource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/dcim/sites/":
+			assert.Equal(t, "active", r.URL.Query().Get("status"))
+			w.Write([]byte(`{"count": 1, "results": [{"id": 1, "display": "Frankfurt", "name": "Frankfurt", "slug": "frankfurt"}]}`))
+		case r.URL.Path == "/api/dcim/sites/1/":
+			w.Write([]byte(`{"id": 1, "display": "Frankfurt", "name": "Frankfurt", "slug": "frankfurt", "status": {"value": "active", "label": "Active"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	server, schemaResp := testConfiguredProviderServer(t, ts.URL)
+	listServer := server.(tfprotov5.ProviderServerWithListResource)
+
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	assert.NoError(t, err)
+	identityType := identitySchemas.IdentitySchemas["netbox_site"].ValueType()
+	resourceType := schemaResp.ResourceSchemas["netbox_site"].ValueType()
+
+	config := testDynamicValue(t, schemaResp.ListResourceSchemas["netbox_site"], map[string]tftypes.Value{
+		"filters": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"status": tftypes.NewValue(tftypes.String, "active"),
+		}),
+	})
+
+	t.Run("identities", func(t *testing.T) {
+		stream, err := listServer.ListResource(ctx, &tfprotov5.ListResourceRequest{
+			TypeName: "netbox_site",
+			Config:   config,
+			Limit:    10,
+		})
+		assert.NoError(t, err)
+
+		count := 0
+		for result := range stream.Results {
+			count++
+			assert.Empty(t, result.Diagnostics)
+			assert.Equal(t, "Frankfurt", result.DisplayName)
+			identity, err := result.Identity.IdentityData.Unmarshal(identityType)
+			assert.NoError(t, err)
+			var attributes map[string]tftypes.Value
+			assert.NoError(t, identity.As(&attributes))
+			assert.True(t, attributes["id"].Equal(tftypes.NewValue(tftypes.Number, 1)))
+			assert.True(t, attributes["slug"].Equal(tftypes.NewValue(tftypes.String, "frankfurt")))
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		stream, err := listServer.ListResource(ctx, &tfprotov5.ListResourceRequest{
+			TypeName:        "netbox_site",
+			Config:          config,
+			IncludeResource: true,
+			Limit:           10,
+		})
+		assert.NoError(t, err)
+
+		count := 0
+		for result := range stream.Results {
+			count++
+			assert.Empty(t, result.Diagnostics)
+			state, err := result.Resource.Unmarshal(resourceType)
+			assert.NoError(t, err)
+			var attributes map[string]tftypes.Value
+			assert.NoError(t, state.As(&attributes))
+			assert.True(t, attributes["id"].Equal(tftypes.NewValue(tftypes.String, "1")))
+			assert.True(t, attributes["slug"].Equal(tftypes.NewValue(tftypes.String, "frankfurt")))
+		}
+		assert.Equal(t, 1, count)
+	})
+}
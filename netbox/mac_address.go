@@ -0,0 +1,46 @@
+package netbox
+
+import (
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// macAddressSchema is the schema of MAC address attributes. Netbox stores
+// MAC addresses in uppercase with colons as separator, so every notation of
+// the same address is considered equal to avoid perpetual diffs.
+var macAddressSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validation.IsMACAddress,
+	DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+		return normalizeMACAddress(old) == normalizeMACAddress(new)
+	},
+	Description: "Accepts any common notation like `aa:bb:cc:dd:ee:ff`, `aa-bb-cc-dd-ee-ff` or `aabb.ccdd.eeff`.",
+}
+
+// interfaceMACAddressSchema is the schema of the MAC address of interfaces.
+// Since Netbox 4.2, MAC addresses are objects of their own and the MAC
+// address of an interface is the one of its primary MAC address, which is
+// read only.
+var interfaceMACAddressSchema = &schema.Schema{
+	Type:             schema.TypeString,
+	Optional:         true,
+	Computed:         true,
+	ValidateFunc:     macAddressSchema.ValidateFunc,
+	DiffSuppressFunc: macAddressSchema.DiffSuppressFunc,
+	Deprecated:       "Netbox 4.2 and later ignore this attribute. Use netbox_mac_address and primary_mac_address instead.",
+	Description:      macAddressSchema.Description + " Netbox 4.2 and later ignore it and set it to the address of `primary_mac_address`.",
+}
+
+// normalizeMACAddress converts the given MAC address to the notation Netbox
+// uses, e.g. `AA:BB:CC:DD:EE:FF`. Invalid MAC addresses are returned as is.
+func normalizeMACAddress(mac string) string {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return mac
+	}
+	return strings.ToUpper(hw.String())
+}
@@ -0,0 +1,19 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMACAddress(t *testing.T) {
+	for input, expected := range map[string]string{
+		"00:16:3E:A8:B5:D7": "00:16:3E:A8:B5:D7",
+		"00:16:3e:a8:b5:d7": "00:16:3E:A8:B5:D7",
+		"00-16-3e-a8-b5-d7": "00:16:3E:A8:B5:D7",
+		"0016.3ea8.b5d7":    "00:16:3E:A8:B5:D7",
+		"invalid":           "invalid",
+	} {
+		assert.Equal(t, expected, normalizeMACAddress(input), input)
+	}
+}
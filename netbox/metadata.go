@@ -0,0 +1,222 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withObjectMetadata adds the computed attributes that every Netbox object
+// has to the given schema of a resource or data source.
+func withObjectMetadata(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The URL of the object in the Netbox API.",
+	}
+	s["display"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the object as displayed by Netbox.",
+	}
+	s["created"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the object was created.",
+	}
+	s["last_updated"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the object was last updated.",
+	}
+	return s
+}
+
+// getObjectMetadata returns the values of the attributes added by
+// withObjectMetadata from a Netbox object of any go-netbox model.
+func getObjectMetadata(object interface{}) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(object))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	metadata := make(map[string]interface{})
+	for key, field := range map[string]string{
+		"url":          "URL",
+		"display":      "Display",
+		"created":      "Created",
+		"last_updated": "LastUpdated",
+	} {
+		f := v.FieldByName(field)
+		if !f.IsValid() || (f.Kind() == reflect.Ptr && f.IsNil()) {
+			metadata[key] = nil
+			continue
+		}
+		metadata[key] = fmt.Sprint(reflect.Indirect(f).Interface())
+	}
+	return metadata
+}
+
+// relatedObjectSchema is the schema of computed attributes that describe an
+// object referenced by an `_id` attribute, so that its name and slug can be
+// used without an additional data source.
+var relatedObjectSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	},
+	Description: "The referenced object with its `id`, `name`, `slug`, `display` and `url`.",
+}
+
+// getRelatedObject returns the value of an attribute with relatedObjectSchema
+// from a nested object of any go-netbox model.
+func getRelatedObject(object interface{}) []map[string]interface{} {
+	v := reflect.ValueOf(object)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	related := make(map[string]interface{})
+	for key, field := range map[string]string{
+		"id":      "ID",
+		"name":    "Name",
+		"slug":    "Slug",
+		"display": "Display",
+		"url":     "URL",
+	} {
+		f := v.FieldByName(field)
+		if !f.IsValid() || (f.Kind() == reflect.Ptr && f.IsNil()) {
+			continue
+		}
+		f = reflect.Indirect(f)
+		if f.Kind() == reflect.Int64 {
+			related[key] = int(f.Int())
+		} else {
+			related[key] = fmt.Sprint(f.Interface())
+		}
+	}
+	return []map[string]interface{}{related}
+}
+
+func setObjectMetadata(d *schema.ResourceData, object interface{}) {
+	for key, value := range getObjectMetadata(object) {
+		d.Set(key, value)
+	}
+}
+
+// wrapExternalModificationCheck makes the update function of the given
+// resource fail if its object was modified in Netbox since it was last read
+// by Terraform, if the provider detects external modifications. It must be
+// applied after wrapAPIErrors.
+func wrapExternalModificationCheck(r *schema.Resource) {
+	if _, ok := r.Schema["last_updated"]; !ok || r.UpdateContext == nil {
+		return
+	}
+	update := r.UpdateContext
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if api, ok := m.(*providerState); ok && api.detectExternalModifications {
+			known, _ := d.Get("last_updated").(string)
+			objectURL, _ := d.Get("url").(string)
+			diags := checkExternalModification(api, objectURL, known)
+			if diags.HasError() {
+				var result diag.Diagnostics
+				for _, diagnostic := range diags {
+					result = append(result, apiErrorDiagnostics(r.Schema, diagnostic)...)
+				}
+				return result
+			}
+		}
+		return update(ctx, d, m)
+	}
+}
+
+// checkExternalModification compares the `last_updated` of the object at
+// objectURL in Netbox with the one in the state.
+func checkExternalModification(api *providerState, objectURL, known string) diag.Diagnostics {
+	if known == "" || objectURL == "" {
+		return nil
+	}
+
+	path, err := apiPathFromURL(objectURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiRequest(api, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	object, _ := res.(map[string]interface{})
+	current, _ := object["last_updated"].(string)
+
+	if isSameTime(known, current) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Object modified outside of Terraform",
+		Detail:   fmt.Sprintf("The object %s was modified in Netbox at %s, after Terraform last read it at %s. Refresh the state and review the plan before updating it.", objectURL, current, known),
+	}}
+}
+
+// apiPathFromURL returns the path of the given API URL relative to the API
+// base path, like /dcim/sites/1/ for https://netbox.example.com/api/dcim/sites/1/.
+func apiPathFromURL(objectURL string) (string, error) {
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return "", err
+	}
+	i := strings.Index(u.Path, "/api/")
+	if i < 0 {
+		return "", fmt.Errorf("%s is not a Netbox API URL", objectURL)
+	}
+	return u.Path[i+len("/api"):], nil
+}
+
+// isSameTime reports whether both timestamps denote the same time. The state
+// only holds timestamps with millisecond precision, while Netbox answers with
+// microseconds.
+func isSameTime(a, b string) bool {
+	ta, err := strfmt.ParseDateTime(a)
+	if err != nil {
+		return a == b
+	}
+	tb, err := strfmt.ParseDateTime(b)
+	if err != nil {
+		return false
+	}
+	return time.Time(ta).Truncate(time.Millisecond).Equal(time.Time(tb).Truncate(time.Millisecond))
+}
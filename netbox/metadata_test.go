@@ -0,0 +1,103 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetObjectMetadata(t *testing.T) {
+	created := strfmt.DateTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	site := &models.Site{
+		URL:     "https://netbox.example.com/api/dcim/sites/1/",
+		Display: "Frankfurt 1",
+		Created: &created,
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"url":          "https://netbox.example.com/api/dcim/sites/1/",
+		"display":      "Frankfurt 1",
+		"created":      "2024-06-01T12:00:00.000Z",
+		"last_updated": nil,
+	}, getObjectMetadata(site))
+
+	assert.Nil(t, getObjectMetadata(nil))
+}
+
+func TestGetRelatedObject(t *testing.T) {
+	site := &models.NestedSite{
+		ID:      1,
+		Name:    strToPtr("Frankfurt 1"),
+		Slug:    strToPtr("frankfurt-1"),
+		Display: "Frankfurt 1",
+		URL:     "https://netbox.example.com/api/dcim/sites/1/",
+	}
+
+	assert.Equal(t, []map[string]interface{}{{
+		"id":      1,
+		"name":    "Frankfurt 1",
+		"slug":    "frankfurt-1",
+		"display": "Frankfurt 1",
+		"url":     "https://netbox.example.com/api/dcim/sites/1/",
+	}}, getRelatedObject(site))
+
+	var tenant *models.NestedTenant
+	assert.Nil(t, getRelatedObject(tenant))
+}
+
+func TestWrapExternalModificationCheck(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dcim/sites/1/", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "last_updated": "2024-06-01T12:00:00.123456Z"}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	updated := false
+	r := &schema.Resource{
+		Schema: withObjectMetadata(map[string]*schema.Schema{}),
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			updated = true
+			return nil
+		},
+	}
+	wrapAPIErrors(r, nil)
+	wrapExternalModificationCheck(r)
+
+	for _, tc := range []struct {
+		detect      bool
+		lastUpdated string
+		updated     bool
+	}{
+		{false, "2024-05-01T08:00:00.000Z", true},
+		{true, "2024-05-01T08:00:00.000Z", false},
+		{true, "2024-06-01T12:00:00.123Z", true},
+	} {
+		api.detectExternalModifications = tc.detect
+		updated = false
+
+		d := r.TestResourceData()
+		d.SetId("1")
+		d.Set("url", ts.URL+"/api/dcim/sites/1/")
+		d.Set("last_updated", tc.lastUpdated)
+		diags := r.UpdateContext(context.Background(), d, api)
+
+		assert.Equal(t, tc.updated, updated, "%v %s", tc.detect, tc.lastUpdated)
+		assert.Equal(t, !tc.updated, diags.HasError(), "%v %s", tc.detect, tc.lastUpdated)
+	}
+}
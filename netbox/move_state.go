@@ -0,0 +1,101 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/exp/slices"
+)
+
+// resourceMoves lists, per target resource type, the resource types whose
+// state can be moved into it with a `moved` block. The state of the source
+// resource has to describe the same Netbox object by its ID.
+var resourceMoves = map[string][]string{
+	// Allocated objects are regular objects once they exist.
+	"netbox_ip_address": {"netbox_available_ip_address"},
+	"netbox_prefix":     {"netbox_available_prefix"},
+	// The primary IP resources are identified by the ID of their virtual
+	// machine or device, whose primary IP addresses are read back on refresh.
+	"netbox_virtual_machine": {"netbox_primary_ip"},
+	"netbox_device":          {"netbox_device_primary_ip"},
+}
+
+// moveStateServer adds MoveResourceState support to the SDKv2 provider
+// server, which does not implement it.
+type moveStateServer struct {
+	tfprotov5.ProviderServer
+}
+
+// MoveResourceState copies the attributes of the source state that the
+// target resource type has as well. All other attributes are set by the
+// refresh that Terraform runs after the move.
+func (s moveStateServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	resp := &tfprotov5.MoveResourceStateResponse{}
+
+	if !strings.HasSuffix(req.SourceProviderAddress, "/netbox") || !slices.Contains(resourceMoves[req.TargetTypeName], req.SourceTypeName) {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Unsupported resource move",
+			Detail:   fmt.Sprintf("The state of %s can not be moved to %s.", req.SourceTypeName, req.TargetTypeName),
+		})
+		return resp, nil
+	}
+
+	schemas, err := s.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	targetSchema, ok := schemas.ResourceSchemas[req.TargetTypeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %s", req.TargetTypeName)
+	}
+
+	targetState, err := moveState(req.SourceState, targetSchema.ValueType())
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Error moving resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	resp.TargetState = targetState
+	return resp, nil
+}
+
+// moveState converts the raw source state to the given object type. Source
+// attributes that are missing in the target are dropped, target attributes
+// that are missing in the source or have a different type are null.
+func moveState(source *tfprotov5.RawState, typ tftypes.Type) (*tfprotov5.DynamicValue, error) {
+	if source == nil || source.JSON == nil {
+		return nil, fmt.Errorf("the source state is empty")
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(source.JSON, &attributes); err != nil {
+		return nil, fmt.Errorf("could not parse the source state: %w", err)
+	}
+	if _, ok := attributes["id"]; !ok {
+		return nil, fmt.Errorf("the source state has no id")
+	}
+
+	objectType := typ.(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if raw, ok := attributes[name]; ok {
+			if value, err := tftypes.ValueFromJSON(raw, attributeType); err == nil {
+				values[name] = value
+			}
+		}
+	}
+
+	state, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
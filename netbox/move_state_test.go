@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveResourceState(t *testing.T) {
+	server := moveStateServer{Provider().GRPCProvider()}
+
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/e-breuninger/netbox",
+		SourceTypeName:        "netbox_primary_ip",
+		TargetTypeName:        "netbox_virtual_machine",
+		SourceState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"12","virtual_machine_id":12,"ip_address_id":3,"ip_address_version":4}`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics[0].Detail)
+	}
+
+	schemas, _ := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	typ := schemas.ResourceSchemas["netbox_virtual_machine"].ValueType()
+	state, err := resp.TargetState.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	var id string
+	if err := attributes["id"].As(&id); err != nil || id != "12" {
+		t.Errorf("expected id 12, got %q", id)
+	}
+	if !attributes["name"].IsNull() {
+		t.Errorf("expected name to be null, got %s", attributes["name"])
+	}
+
+	resp, err = server.MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/e-breuninger/netbox",
+		SourceTypeName:        "netbox_tag",
+		TargetTypeName:        "netbox_virtual_machine",
+		SourceState:           &tfprotov5.RawState{JSON: []byte(`{"id":"12"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary != "Unsupported resource move" {
+		t.Errorf("expected an unsupported resource move diagnostic, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	log "github.com/sirupsen/logrus"
+)
+
+// operationMaxRetries is how often an operation is retried after Netbox
+// answered one of its requests with a conflict or a server error. It is
+// independent of max_retries, which retries single requests in the provider
+// transport.
+const operationMaxRetries = 3
+
+// operationRetryMinDelay is the delay before the first retry of an
+// operation. It doubles with every further retry.
+var operationRetryMinDelay = 2 * time.Second
+
+// apiErrorStatusPattern matches the start of the messages of errors returned
+// by go-netbox and apiRequest. Unlike apiErrorPattern it does not require a
+// JSON body, which proxies in front of Netbox do not send.
+var apiErrorStatusPattern = regexp.MustCompile(`^\[([A-Z]+) [^\]]*\]\[(\d{3})\]`)
+
+// withOperationRetry retries an update or delete operation when a write
+// request failed with a conflict (409), e.g. because an object that is
+// deleted in parallel still protects the deleted object, or when any request
+// failed with a bad gateway (502) or service unavailable (503) response.
+func withOperationRetry(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return retryOperation(ctx, d.Id(), func() (diag.Diagnostics, bool) {
+			return f(ctx, d, m), true
+		})
+	}
+}
+
+// withAllocationRetry retries the create operation of a resource that
+// allocates the next available object in Netbox, like an IP address or a
+// prefix, on the same responses as withOperationRetry. Parallel allocations
+// from the same prefix or range race with each other, and the losers are
+// answered with a conflict because the object is already allocated. Every
+// attempt requests the available objects again, so a retry allocates the next
+// free one. Once the object has been allocated, e.g. when only updating it
+// afterwards failed, the create is not retried, so that no second object is
+// allocated.
+func withAllocationRetry(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return retryOperation(ctx, "", func() (diag.Diagnostics, bool) {
+			return f(ctx, d, m), d.Id() == ""
+		})
+	}
+}
+
+// retryOperation runs the operation on the object with the given ID again as
+// long as it fails with a conflict or a server error, see withOperationRetry,
+// and as long as f reports that it can be retried.
+func retryOperation(ctx context.Context, id string, f func() (diag.Diagnostics, bool)) diag.Diagnostics {
+	for attempt := 0; ; attempt++ {
+		diags, retryable := f()
+
+		method, statusCode, ok := retryableErrorStatus(diags)
+		if !ok || !retryable || attempt >= operationMaxRetries {
+			return diags
+		}
+
+		delay := operationRetryMinDelay << attempt
+		log.WithFields(log.Fields{
+			"id":          id,
+			"method":      method,
+			"status_code": statusCode,
+			"attempt":     attempt + 1,
+			"delay":       delay.String(),
+		}).Warn("Retrying operation after error response from Netbox")
+
+		select {
+		case <-ctx.Done():
+			return diags
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryableErrorStatus returns the method and the status code of the first
+// error in diags that was caused by a conflict response to a write request or
+// by a bad gateway or service unavailable response to any request.
+func retryableErrorStatus(diags diag.Diagnostics) (string, int, bool) {
+	for _, diagnostic := range diags {
+		if diagnostic.Severity != diag.Error {
+			continue
+		}
+		match := apiErrorStatusPattern.FindStringSubmatch(diagnostic.Summary)
+		if match == nil {
+			continue
+		}
+		switch statusCode, _ := strconv.Atoi(match[2]); statusCode {
+		case http.StatusConflict:
+			if match[1] != http.MethodGet {
+				return match[1], statusCode, true
+			}
+		case http.StatusBadGateway, http.StatusServiceUnavailable:
+			return match[1], statusCode, true
+		}
+	}
+	return "", 0, false
+}
@@ -0,0 +1,92 @@
+package netbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithOperationRetry(t *testing.T) {
+	defer func(delay time.Duration) { operationRetryMinDelay = delay }(operationRetryMinDelay)
+	operationRetryMinDelay = 0
+
+	conflict := ipam.NewIpamPrefixesDeleteDefault(409)
+	conflict.Payload = map[string]interface{}{"detail": "Unable to delete object. 1 dependent objects were found."}
+	unavailable := ipam.NewIpamPrefixesDeleteDefault(503)
+	unavailable.Payload = map[string]interface{}{"detail": "Service unavailable"}
+	badGateway := ipam.NewIpamPrefixesReadDefault(502)
+	badGateway.Payload = map[string]interface{}{"detail": "Bad gateway"}
+	readConflict := ipam.NewIpamPrefixesReadDefault(409)
+	readConflict.Payload = map[string]interface{}{"detail": "Conflict"}
+	invalid := ipam.NewIpamPrefixesDeleteDefault(400)
+	invalid.Payload = map[string]interface{}{"status": []interface{}{"\"foo\" is not a valid choice."}}
+
+	for _, tc := range []struct {
+		name     string
+		errs     []error
+		attempts int
+		hasError bool
+	}{
+		{name: "success", errs: []error{nil}, attempts: 1},
+		{name: "conflict", errs: []error{conflict, conflict, nil}, attempts: 3},
+		{name: "persistent conflict", errs: []error{conflict, conflict, conflict, conflict, conflict}, attempts: 4, hasError: true},
+		{name: "validation error", errs: []error{invalid, nil}, attempts: 1, hasError: true},
+		{name: "server error", errs: []error{unavailable, badGateway, nil}, attempts: 3},
+		{name: "persistent server error", errs: []error{unavailable, unavailable, unavailable, unavailable, unavailable}, attempts: 4, hasError: true},
+		{name: "read conflict", errs: []error{readConflict, nil}, attempts: 1, hasError: true},
+	} {
+		attempts := 0
+		del := withOperationRetry(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			err := tc.errs[attempts]
+			attempts++
+			return diag.FromErr(err)
+		})
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		diags := del(context.Background(), d, nil)
+
+		assert.Equal(t, tc.attempts, attempts, tc.name)
+		assert.Equal(t, tc.hasError, diags.HasError(), tc.name)
+	}
+}
+
+func TestWithAllocationRetry(t *testing.T) {
+	defer func(delay time.Duration) { operationRetryMinDelay = delay }(operationRetryMinDelay)
+	operationRetryMinDelay = 0
+
+	conflict := ipam.NewIpamPrefixesAvailableIpsCreateDefault(409)
+	conflict.Payload = map[string]interface{}{"detail": "The requested IP address is already allocated."}
+	unavailable := ipam.NewIpamPrefixesUpdateDefault(503)
+	unavailable.Payload = map[string]interface{}{"detail": "Service unavailable"}
+
+	for _, tc := range []struct {
+		name     string
+		errs     []error
+		ids      []string
+		attempts int
+		hasError bool
+	}{
+		{name: "success", errs: []error{nil}, ids: []string{"1"}, attempts: 1},
+		{name: "allocation conflict", errs: []error{conflict, conflict, nil}, ids: []string{"", "", "1"}, attempts: 3},
+		{name: "allocated", errs: []error{unavailable, nil}, ids: []string{"1", "2"}, attempts: 1, hasError: true},
+	} {
+		attempts := 0
+		create := withAllocationRetry(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			err := tc.errs[attempts]
+			d.SetId(tc.ids[attempts])
+			attempts++
+			return diag.FromErr(err)
+		})
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		diags := create(context.Background(), d, nil)
+
+		assert.Equal(t, tc.attempts, attempts, tc.name)
+		assert.Equal(t, tc.hasError, diags.HasError(), tc.name)
+	}
+}
@@ -0,0 +1,277 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// listPageSize is the page size requested by listAll. Netbox returns smaller
+// pages if its MAX_PAGE_SIZE is lower.
+const listPageSize = 1000
+
+var listOffsetSchema = &schema.Schema{
+	Type:             schema.TypeInt,
+	Optional:         true,
+	ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+	Description:      "The number of matching objects to skip.",
+}
+
+var listOrderBySchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^-?\w+$`), "must be a field name, optionally prefixed with -"),
+	},
+	Description: "The fields to order the objects by, e.g. `[\"site\", \"-name\"]`. Prefix a field with `-` to order in descending order. By default, the objects are ordered like in the Netbox UI.",
+}
+
+// getListOrdering returns the value of the ordering query parameter for the
+// order_by attribute.
+func getListOrdering(d *schema.ResourceData) *string {
+	var fields []string
+	for _, field := range d.Get("order_by").([]interface{}) {
+		fields = append(fields, field.(string))
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return strToPtr(strings.Join(fields, ","))
+}
+
+// listAll reads all pages of a list endpoint, starting at the given offset.
+// list is called with the limit and offset of every page and returns the
+// results of the page together with the total number of matching objects.
+// If limit is greater than zero, at most limit results are returned.
+func listAll[T any](limit, offset int64, list func(limit, offset int64) ([]T, int64, error)) ([]T, error) {
+	var results []T
+	for {
+		pageSize := int64(listPageSize)
+		if limit > 0 && limit-int64(len(results)) < pageSize {
+			pageSize = limit - int64(len(results))
+		}
+
+		page, count, err := list(pageSize, offset+int64(len(results)))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page...)
+
+		if limit > 0 && int64(len(results)) >= limit {
+			return results[:limit], nil
+		}
+		if len(page) == 0 || offset+int64(len(results)) >= count {
+			return results, nil
+		}
+	}
+}
+
+var listNameRegexSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validation.StringIsValidRegExp,
+	Description:  "A regular expression that the names of the objects have to match. It is applied to the objects returned by Netbox, after `limit` and `offset`.",
+}
+
+// filterByRegex returns the items whose field matches the regular expression
+// in the given attribute, or all items if the attribute is not set.
+func filterByRegex[T any](d *schema.ResourceData, key string, items []T, field func(T) string) []T {
+	pattern, ok := d.GetOk(key)
+	if !ok {
+		return items
+	}
+	r := regexp.MustCompile(pattern.(string))
+	var filtered []T
+	for _, item := range items {
+		if r.MatchString(field(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+var listBriefSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Request the brief representation of the objects, which is a lot faster for many objects. Only the ID, name, description, `display`, `url` and similar identifying attributes of the objects are set then.",
+}
+
+// getBriefMappings returns the attributes of the objects that Netbox includes
+// in their brief representation. fields maps the attributes to the fields of
+// the go-netbox model, the object metadata is always included.
+func getBriefMappings[T any](objects []T, fields map[string]string) []map[string]interface{} {
+	var mappings []map[string]interface{}
+	for _, object := range objects {
+		v := reflect.Indirect(reflect.ValueOf(object))
+		mapping := getObjectMetadata(object)
+		for key, field := range fields {
+			f := v.FieldByName(field)
+			if !f.IsValid() || (f.Kind() == reflect.Ptr && f.IsNil()) {
+				continue
+			}
+			mapping[key] = reflect.Indirect(f).Interface()
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+var listTagsSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Description: "Slugs of tags that the objects must all have.",
+}
+
+// addListTagsQuery adds the tags of the `tags` attribute to the query.
+// Netbox returns the objects that have all of them.
+func addListTagsQuery(d *schema.ResourceData, query url.Values) {
+	for _, tag := range toStringList(d.Get("tags")) {
+		query.Add("tag", tag)
+	}
+}
+
+var listCustomFieldFilterSchema = &schema.Schema{
+	Type:     schema.TypeMap,
+	Optional: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Description: "Values of custom fields that the objects must have, e.g. `{ env = \"prod\" }`. Values are compared like the `cf_<custom field>` filters of the Netbox API compare them.",
+}
+
+// addListCustomFieldQuery adds the `cf_<custom field>` filters of the
+// `custom_field_filter` attribute to the query.
+func addListCustomFieldQuery(d *schema.ResourceData, query url.Values) {
+	for name, value := range d.Get("custom_field_filter").(map[string]interface{}) {
+		query.Add("cf_"+name, value.(string))
+	}
+}
+
+// listDataSource describes a plural data source.
+type listDataSource struct {
+	// key is the attribute holding the list of objects.
+	key string
+	// idKey is the attribute of the objects holding their ID.
+	idKey string
+}
+
+var listDataSources = map[string]listDataSource{
+	"netbox_asns":              {"asns", "id"},
+	"netbox_device_interfaces": {"interfaces", "id"},
+	"netbox_devices":           {"devices", "device_id"},
+	"netbox_interfaces":        {"interfaces", "id"},
+	"netbox_ip_addresses":      {"ip_addresses", "id"},
+	"netbox_locations":         {"locations", "id"},
+	"netbox_prefixes":          {"prefixes", "id"},
+	"netbox_racks":             {"racks", "id"},
+	"netbox_tags":              {"tags", "tag_id"},
+	"netbox_tenants":           {"tenants", "id"},
+	"netbox_virtual_machines":  {"vms", "vm_id"},
+	"netbox_vlans":             {"vlans", "vid"},
+	"netbox_vrfs":              {"vrfs", "id"},
+}
+
+// wrapFieldSelection adds a `fields` attribute to the given plural data
+// source. If it is set, only the listed attributes of the objects in the
+// list attribute are kept in the state.
+func wrapFieldSelection(r *schema.Resource, list listDataSource) {
+	key := list.key
+	var attributes []string
+	for attribute := range r.Schema[key].Elem.(*schema.Resource).Schema {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	r.Schema["fields"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(attributes, false),
+		},
+		Description: fmt.Sprintf("The attributes of the objects in `%s` to set, e.g. `[\"name\"]`. All other attributes are left empty, which keeps the state small.", key),
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		fields := toStringList(d.Get("fields"))
+		if diags.HasError() || len(fields) == 0 {
+			return diags
+		}
+
+		objects := d.Get(key).([]interface{})
+		for i, object := range objects {
+			selected := make(map[string]interface{}, len(fields))
+			for _, field := range fields {
+				selected[field] = object.(map[string]interface{})[field]
+			}
+			objects[i] = selected
+		}
+		if err := d.Set(key, objects); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// wrapKeyedOutputs adds `_by_name` and `_by_slug` attributes to the given
+// plural data source, mapping the names and slugs of the listed objects to
+// their IDs, for objects that have a name or slug.
+func wrapKeyedOutputs(r *schema.Resource, list listDataSource) {
+	elem := r.Schema[list.key].Elem.(*schema.Resource).Schema
+	var keys []string
+	for _, key := range []string{"name", "slug"} {
+		if _, ok := elem[key]; !ok {
+			continue
+		}
+		keys = append(keys, key)
+		r.Schema[list.key+"_by_"+key] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+			Description: fmt.Sprintf("The `%s` of the objects in `%s`, keyed by their %s. If several objects have the same %s, the first one is used.", list.idKey, list.key, key, key),
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, key := range keys {
+			ids := make(map[string]interface{})
+			for _, object := range d.Get(list.key).([]interface{}) {
+				attributes := object.(map[string]interface{})
+				value, _ := attributes[key].(string)
+				if _, ok := ids[value]; value != "" && !ok {
+					ids[value] = attributes[list.idKey]
+				}
+			}
+			if err := d.Set(list.key+"_by_"+key, ids); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+}
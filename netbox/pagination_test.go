@@ -0,0 +1,180 @@
+package netbox
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAll(t *testing.T) {
+	objects := make([]int, 2500)
+	for i := range objects {
+		objects[i] = i
+	}
+	list := func(limit, offset int64) ([]int, int64, error) {
+		// Netbox caps the page size at MAX_PAGE_SIZE
+		end := min(offset+min(limit, 1000), int64(len(objects)))
+		return objects[offset:end], int64(len(objects)), nil
+	}
+
+	results, err := listAll(0, 0, list)
+	assert.NoError(t, err)
+	assert.Equal(t, objects, results)
+
+	results, err = listAll(1200, 0, list)
+	assert.NoError(t, err)
+	assert.Equal(t, objects[:1200], results)
+
+	results, err = listAll(0, 2000, list)
+	assert.NoError(t, err)
+	assert.Equal(t, objects[2000:], results)
+
+	results, err = listAll(0, 0, func(limit, offset int64) ([]int, int64, error) {
+		return nil, 0, nil
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestGetListOrdering(t *testing.T) {
+	listSchema := map[string]*schema.Schema{"order_by": listOrderBySchema}
+
+	d := schema.TestResourceDataRaw(t, listSchema, map[string]interface{}{})
+	assert.Nil(t, getListOrdering(d))
+
+	d = schema.TestResourceDataRaw(t, listSchema, map[string]interface{}{"order_by": []interface{}{"site", "-name"}})
+	assert.Equal(t, "site,-name", *getListOrdering(d))
+}
+
+func TestFilterByRegex(t *testing.T) {
+	regexSchema := map[string]*schema.Schema{"name_regex": listNameRegexSchema}
+	names := []string{"sw01", "sw02", "rtr01"}
+	name := func(s string) string { return s }
+
+	d := schema.TestResourceDataRaw(t, regexSchema, map[string]interface{}{})
+	assert.Equal(t, names, filterByRegex(d, "name_regex", names, name))
+
+	d = schema.TestResourceDataRaw(t, regexSchema, map[string]interface{}{"name_regex": "^sw"})
+	assert.Equal(t, []string{"sw01", "sw02"}, filterByRegex(d, "name_regex", names, name))
+}
+
+func TestAddListTagsQuery(t *testing.T) {
+	tagsSchema := map[string]*schema.Schema{"tags": listTagsSchema}
+
+	query := url.Values{}
+	d := schema.TestResourceDataRaw(t, tagsSchema, map[string]interface{}{})
+	addListTagsQuery(d, query)
+	assert.Empty(t, query)
+
+	query = url.Values{"tag": {"dmz"}}
+	d = schema.TestResourceDataRaw(t, tagsSchema, map[string]interface{}{"tags": []interface{}{"k8s-node"}})
+	addListTagsQuery(d, query)
+	assert.Equal(t, []string{"dmz", "k8s-node"}, query["tag"])
+}
+
+func TestAddListCustomFieldQuery(t *testing.T) {
+	customFieldSchema := map[string]*schema.Schema{"custom_field_filter": listCustomFieldFilterSchema}
+
+	query := url.Values{}
+	d := schema.TestResourceDataRaw(t, customFieldSchema, map[string]interface{}{
+		"custom_field_filter": map[string]interface{}{"env": "prod", "owner": "network"},
+	})
+	addListCustomFieldQuery(d, query)
+	assert.Equal(t, url.Values{"cf_env": {"prod"}, "cf_owner": {"network"}}, query)
+}
+
+func TestGetBriefMappings(t *testing.T) {
+	tags := []*models.Tag{
+		{ID: 1, Name: strToPtr("dmz"), Display: "dmz", URL: "http://netbox/api/extras/tags/1/"},
+		{ID: 2, Display: "core"},
+	}
+
+	mappings := getBriefMappings(tags, map[string]string{"tag_id": "ID", "name": "Name"})
+	assert.Len(t, mappings, 2)
+	assert.Equal(t, int64(1), mappings[0]["tag_id"])
+	assert.Equal(t, "dmz", mappings[0]["name"])
+	assert.Equal(t, "dmz", mappings[0]["display"])
+	assert.Equal(t, "http://netbox/api/extras/tags/1/", mappings[0]["url"])
+	assert.NotContains(t, mappings[1], "name")
+}
+
+func TestListDataSources(t *testing.T) {
+	p := Provider()
+	for name, list := range listDataSources {
+		r, ok := p.DataSourcesMap[name]
+		if !assert.True(t, ok, name) {
+			continue
+		}
+		assert.Contains(t, r.Schema, list.key, name)
+		assert.Contains(t, r.Schema[list.key].Elem.(*schema.Resource).Schema, list.idKey, name)
+		assert.Contains(t, r.Schema, "fields", name)
+	}
+}
+
+func TestWrapFieldSelection(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true},
+						"description": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.SetId("1")
+			return diag.FromErr(d.Set("tags", []map[string]interface{}{{"name": "dmz", "description": "Demilitarized zone"}}))
+		},
+	}
+	wrapFieldSelection(r, listDataSource{"tags", "name"})
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"fields": []interface{}{"name"}})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	assert.Equal(t, "dmz", d.Get("tags.0.name"))
+	assert.Equal(t, "", d.Get("tags.0.description"))
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"fields": []interface{}{"color"}}))
+	assert.True(t, diags.HasError())
+}
+
+func TestWrapKeyedOutputs(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_id": {Type: schema.TypeInt, Computed: true},
+						"name":   {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.SetId("1")
+			return diag.FromErr(d.Set("tags", []map[string]interface{}{
+				{"tag_id": 1, "name": "dmz"},
+				{"tag_id": 2, "name": "core"},
+				{"tag_id": 3, "name": "dmz"},
+			}))
+		},
+	}
+	wrapKeyedOutputs(r, listDataSource{"tags", "tag_id"})
+	assert.Contains(t, r.Schema, "tags_by_name")
+	assert.NotContains(t, r.Schema, "tags_by_slug")
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	assert.False(t, r.ReadContext(context.Background(), d, nil).HasError())
+	assert.Equal(t, map[string]interface{}{"dmz": 1, "core": 2}, d.Get("tags_by_name"))
+}
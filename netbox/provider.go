@@ -4,14 +4,24 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"sync"
 
+	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"golang.org/x/exp/slices"
 )
 
+// Version is the version of the provider. It is overridden at build time.
+var Version = "dev"
+
 // This makes the description contain the default value, particularly useful for the docs
 // From https://github.com/hashicorp/terraform-plugin-docs/issues/65#issuecomment-1152842370
 func init() {
@@ -69,90 +79,130 @@ func init() {
 	}
 }
 
+// ProviderOption customizes the provider for programs that embed it instead of
+// running it as a plugin.
+type ProviderOption func(*providerOptions)
+
+type providerOptions struct {
+	transportWrapper func(http.RoundTripper) http.RoundTripper
+}
+
+// WithTransportWrapper wraps the HTTP transport that sends the requests to
+// Netbox, e.g. to sign requests or to record traces. The wrapper receives the
+// request after all headers including authentication are set and sees every
+// retry separately.
+func WithTransportWrapper(wrapper func(http.RoundTripper) http.RoundTripper) ProviderOption {
+	return func(o *providerOptions) {
+		o.transportWrapper = wrapper
+	}
+}
+
 // Provider returns a schema.Provider for Netbox.
-func Provider() *schema.Provider {
+func Provider(opts ...ProviderOption) *schema.Provider {
+	var options providerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"netbox_available_ip_address":       resourceNetboxAvailableIPAddress(),
-			"netbox_virtual_machine":            resourceNetboxVirtualMachine(),
-			"netbox_cluster_type":               resourceNetboxClusterType(),
-			"netbox_cluster":                    resourceNetboxCluster(),
-			"netbox_contact":                    resourceNetboxContact(),
-			"netbox_contact_group":              resourceNetboxContactGroup(),
-			"netbox_contact_assignment":         resourceNetboxContactAssignment(),
-			"netbox_contact_role":               resourceNetboxContactRole(),
-			"netbox_device":                     resourceNetboxDevice(),
-			"netbox_device_interface":           resourceNetboxDeviceInterface(),
-			"netbox_device_type":                resourceNetboxDeviceType(),
-			"netbox_manufacturer":               resourceNetboxManufacturer(),
-			"netbox_tenant":                     resourceNetboxTenant(),
-			"netbox_tenant_group":               resourceNetboxTenantGroup(),
-			"netbox_vrf":                        resourceNetboxVrf(),
-			"netbox_ip_address":                 resourceNetboxIPAddress(),
-			"netbox_interface_template":         resourceNetboxInterfaceTemplate(),
-			"netbox_interface":                  resourceNetboxInterface(),
-			"netbox_service":                    resourceNetboxService(),
-			"netbox_platform":                   resourceNetboxPlatform(),
-			"netbox_prefix":                     resourceNetboxPrefix(),
-			"netbox_available_prefix":           resourceNetboxAvailablePrefix(),
-			"netbox_primary_ip":                 resourceNetboxPrimaryIP(),
-			"netbox_device_primary_ip":          resourceNetboxDevicePrimaryIP(),
-			"netbox_device_role":                resourceNetboxDeviceRole(),
-			"netbox_tag":                        resourceNetboxTag(),
-			"netbox_cluster_group":              resourceNetboxClusterGroup(),
-			"netbox_site":                       resourceNetboxSite(),
-			"netbox_vlan":                       resourceNetboxVlan(),
-			"netbox_vlan_group":                 resourceNetboxVlanGroup(),
-			"netbox_ipam_role":                  resourceNetboxIpamRole(),
-			"netbox_ip_range":                   resourceNetboxIPRange(),
-			"netbox_region":                     resourceNetboxRegion(),
-			"netbox_aggregate":                  resourceNetboxAggregate(),
-			"netbox_rir":                        resourceNetboxRir(),
-			"netbox_route_target":               resourceNetboxRouteTarget(),
-			"netbox_circuit":                    resourceNetboxCircuit(),
-			"netbox_circuit_type":               resourceNetboxCircuitType(),
-			"netbox_circuit_provider":           resourceNetboxCircuitProvider(),
-			"netbox_circuit_termination":        resourceNetboxCircuitTermination(),
-			"netbox_user":                       resourceNetboxUser(),
-			"netbox_group":                      resourceNetboxGroup(),
-			"netbox_permission":                 resourceNetboxPermission(),
-			"netbox_token":                      resourceNetboxToken(),
-			"netbox_custom_field":               resourceCustomField(),
-			"netbox_asn":                        resourceNetboxAsn(),
-			"netbox_location":                   resourceNetboxLocation(),
-			"netbox_site_group":                 resourceNetboxSiteGroup(),
-			"netbox_rack":                       resourceNetboxRack(),
-			"netbox_rack_role":                  resourceNetboxRackRole(),
-			"netbox_rack_reservation":           resourceNetboxRackReservation(),
-			"netbox_cable":                      resourceNetboxCable(),
-			"netbox_device_console_port":        resourceNetboxDeviceConsolePort(),
-			"netbox_device_console_server_port": resourceNetboxDeviceConsoleServerPort(),
-			"netbox_device_power_port":          resourceNetboxDevicePowerPort(),
-			"netbox_device_power_outlet":        resourceNetboxDevicePowerOutlet(),
-			"netbox_device_front_port":          resourceNetboxDeviceFrontPort(),
-			"netbox_device_rear_port":           resourceNetboxDeviceRearPort(),
-			"netbox_device_module_bay":          resourceNetboxDeviceModuleBay(),
-			"netbox_module":                     resourceNetboxModule(),
-			"netbox_module_type":                resourceNetboxModuleType(),
-			"netbox_power_feed":                 resourceNetboxPowerFeed(),
-			"netbox_power_panel":                resourceNetboxPowerPanel(),
-			"netbox_inventory_item_role":        resourceNetboxInventoryItemRole(),
-			"netbox_inventory_item":             resourceNetboxInventoryItem(),
-			"netbox_webhook":                    resourceNetboxWebhook(),
-			"netbox_custom_field_choice_set":    resourceNetboxCustomFieldChoiceSet(),
-			"netbox_virtual_chassis":            resourceNetboxVirtualChassis(),
-			"netbox_virtual_disk":               resourceNetboxVirtualDisks(),
-			"netbox_config_template":            resourceNetboxConfigTemplate(),
-			"netbox_event_rule":                 resourceNetboxEventRule(),
-			"netbox_vpn_tunnel_group":           resourceNetboxVpnTunnelGroup(),
-			"netbox_vpn_tunnel":                 resourceNetboxVpnTunnel(),
-			"netbox_vpn_tunnel_termination":     resourceNetboxVpnTunnelTermination(),
-			"netbox_config_context":             resourceNetboxConfigContext(),
+			"netbox_available_ip_address":         resourceNetboxAvailableIPAddress(),
+			"netbox_virtual_machine":              resourceNetboxVirtualMachine(),
+			"netbox_cluster_type":                 resourceNetboxClusterType(),
+			"netbox_cluster":                      resourceNetboxCluster(),
+			"netbox_contact":                      resourceNetboxContact(),
+			"netbox_contact_group":                resourceNetboxContactGroup(),
+			"netbox_contact_assignment":           resourceNetboxContactAssignment(),
+			"netbox_contact_role":                 resourceNetboxContactRole(),
+			"netbox_device":                       resourceNetboxDevice(),
+			"netbox_device_interface":             resourceNetboxDeviceInterface(),
+			"netbox_mac_address":                  resourceNetboxMACAddress(),
+			"netbox_device_type":                  resourceNetboxDeviceType(),
+			"netbox_device_type_import":           resourceNetboxDeviceTypeImport(),
+			"netbox_manufacturer":                 resourceNetboxManufacturer(),
+			"netbox_tenant":                       resourceNetboxTenant(),
+			"netbox_tenant_group":                 resourceNetboxTenantGroup(),
+			"netbox_vrf":                          resourceNetboxVrf(),
+			"netbox_ip_address":                   resourceNetboxIPAddress(),
+			"netbox_ip_address_set":               resourceNetboxIPAddressSet(),
+			"netbox_interface_template":           resourceNetboxInterfaceTemplate(),
+			"netbox_console_port_template":        resourceNetboxConsolePortTemplate(),
+			"netbox_console_server_port_template": resourceNetboxConsoleServerPortTemplate(),
+			"netbox_power_port_template":          resourceNetboxPowerPortTemplate(),
+			"netbox_power_outlet_template":        resourceNetboxPowerOutletTemplate(),
+			"netbox_front_port_template":          resourceNetboxFrontPortTemplate(),
+			"netbox_rear_port_template":           resourceNetboxRearPortTemplate(),
+			"netbox_module_bay_template":          resourceNetboxModuleBayTemplate(),
+			"netbox_inventory_item_template":      resourceNetboxInventoryItemTemplate(),
+			"netbox_device_bay_template":          resourceNetboxDeviceBayTemplate(),
+			"netbox_interface":                    resourceNetboxInterface(),
+			"netbox_service":                      resourceNetboxService(),
+			"netbox_platform":                     resourceNetboxPlatform(),
+			"netbox_prefix":                       resourceNetboxPrefix(),
+			"netbox_available_prefix":             resourceNetboxAvailablePrefix(),
+			"netbox_primary_ip":                   resourceNetboxPrimaryIP(),
+			"netbox_device_primary_ip":            resourceNetboxDevicePrimaryIP(),
+			"netbox_device_role":                  resourceNetboxDeviceRole(),
+			"netbox_tag":                          resourceNetboxTag(),
+			"netbox_tag_assignment":               resourceNetboxTagAssignment(),
+			"netbox_cluster_group":                resourceNetboxClusterGroup(),
+			"netbox_site":                         resourceNetboxSite(),
+			"netbox_vlan":                         resourceNetboxVlan(),
+			"netbox_vlan_group":                   resourceNetboxVlanGroup(),
+			"netbox_ipam_role":                    resourceNetboxIpamRole(),
+			"netbox_ip_range":                     resourceNetboxIPRange(),
+			"netbox_region":                       resourceNetboxRegion(),
+			"netbox_aggregate":                    resourceNetboxAggregate(),
+			"netbox_rir":                          resourceNetboxRir(),
+			"netbox_route_target":                 resourceNetboxRouteTarget(),
+			"netbox_circuit":                      resourceNetboxCircuit(),
+			"netbox_circuit_type":                 resourceNetboxCircuitType(),
+			"netbox_circuit_provider":             resourceNetboxCircuitProvider(),
+			"netbox_circuit_termination":          resourceNetboxCircuitTermination(),
+			"netbox_user":                         resourceNetboxUser(),
+			"netbox_permission":                   resourceNetboxPermission(),
+			"netbox_token":                        resourceNetboxToken(),
+			"netbox_custom_field":                 resourceCustomField(),
+			"netbox_asn":                          resourceNetboxAsn(),
+			"netbox_location":                     resourceNetboxLocation(),
+			"netbox_site_group":                   resourceNetboxSiteGroup(),
+			"netbox_rack":                         resourceNetboxRack(),
+			"netbox_rack_role":                    resourceNetboxRackRole(),
+			"netbox_rack_type":                    resourceNetboxRackType(),
+			"netbox_rack_reservation":             resourceNetboxRackReservation(),
+			"netbox_cable":                        resourceNetboxCable(),
+			"netbox_device_console_port":          resourceNetboxDeviceConsolePort(),
+			"netbox_device_console_server_port":   resourceNetboxDeviceConsoleServerPort(),
+			"netbox_device_power_port":            resourceNetboxDevicePowerPort(),
+			"netbox_device_power_outlet":          resourceNetboxDevicePowerOutlet(),
+			"netbox_device_front_port":            resourceNetboxDeviceFrontPort(),
+			"netbox_device_rear_port":             resourceNetboxDeviceRearPort(),
+			"netbox_device_module_bay":            resourceNetboxDeviceModuleBay(),
+			"netbox_device_bay":                   resourceNetboxDeviceBay(),
+			"netbox_module":                       resourceNetboxModule(),
+			"netbox_module_type":                  resourceNetboxModuleType(),
+			"netbox_power_feed":                   resourceNetboxPowerFeed(),
+			"netbox_power_panel":                  resourceNetboxPowerPanel(),
+			"netbox_inventory_item_role":          resourceNetboxInventoryItemRole(),
+			"netbox_inventory_item":               resourceNetboxInventoryItem(),
+			"netbox_webhook":                      resourceNetboxWebhook(),
+			"netbox_custom_field_choice_set":      resourceNetboxCustomFieldChoiceSet(),
+			"netbox_virtual_chassis":              resourceNetboxVirtualChassis(),
+			"netbox_virtual_device_context":       resourceNetboxVirtualDeviceContext(),
+			"netbox_virtual_disk":                 resourceNetboxVirtualDisks(),
+			"netbox_config_template":              resourceNetboxConfigTemplate(),
+			"netbox_event_rule":                   resourceNetboxEventRule(),
+			"netbox_vpn_tunnel_group":             resourceNetboxVpnTunnelGroup(),
+			"netbox_vpn_tunnel":                   resourceNetboxVpnTunnel(),
+			"netbox_vpn_tunnel_termination":       resourceNetboxVpnTunnelTermination(),
+			"netbox_config_context":               resourceNetboxConfigContext(),
+			"netbox_raw_object":                   resourceNetboxRawObject(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"netbox_asn":               dataSourceNetboxAsn(),
 			"netbox_asns":              dataSourceNetboxAsns(),
 			"netbox_available_prefix":  dataSourceNetboxAvailablePrefix(),
+			"netbox_cable_trace":       dataSourceNetboxCableTrace(),
 			"netbox_cluster":           dataSourceNetboxCluster(),
 			"netbox_cluster_group":     dataSourceNetboxClusterGroup(),
 			"netbox_cluster_type":      dataSourceNetboxClusterType(),
@@ -165,16 +215,19 @@ func Provider() *schema.Provider {
 			"netbox_vrf":               dataSourceNetboxVrf(),
 			"netbox_vrfs":              dataSourceNetboxVrfs(),
 			"netbox_platform":          dataSourceNetboxPlatform(),
+			"netbox_platforms":         dataSourceNetboxPlatforms(),
 			"netbox_prefix":            dataSourceNetboxPrefix(),
 			"netbox_prefixes":          dataSourceNetboxPrefixes(),
 			"netbox_devices":           dataSourceNetboxDevices(),
 			"netbox_device_role":       dataSourceNetboxDeviceRole(),
+			"netbox_device_roles":      dataSourceNetboxDeviceRoles(),
 			"netbox_device_type":       dataSourceNetboxDeviceType(),
 			"netbox_site":              dataSourceNetboxSite(),
 			"netbox_location":          dataSourceNetboxLocation(),
 			"netbox_locations":         dataSourceNetboxLocations(),
 			"netbox_tag":               dataSourceNetboxTag(),
 			"netbox_tags":              dataSourceNetboxTags(),
+			"netbox_virtual_chassis":   dataSourceNetboxVirtualChassis(),
 			"netbox_virtual_machines":  dataSourceNetboxVirtualMachine(),
 			"netbox_interfaces":        dataSourceNetboxInterfaces(),
 			"netbox_device_interfaces": dataSourceNetboxDeviceInterfaces(),
@@ -188,21 +241,47 @@ func Provider() *schema.Provider {
 			"netbox_vlan_group":        dataSourceNetboxVlanGroup(),
 			"netbox_site_group":        dataSourceNetboxSiteGroup(),
 			"netbox_racks":             dataSourceNetboxRacks(),
+			"netbox_rack_elevation":    dataSourceNetboxRackElevation(),
 			"netbox_rack_role":         dataSourceNetboxRackRole(),
 			"netbox_config_context":    dataSourceNetboxConfigContext(),
+			"netbox_graphql":           dataSourceNetboxGraphQL(),
+			"netbox_query":             dataSourceNetboxQuery(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_SERVER_URL", nil),
-				Description: "Location of Netbox server including scheme (http or https) and optional port. Can be set via the `NETBOX_SERVER_URL` environment variable.",
+				Description: "Location of Netbox server including scheme (http or https) and optional port. Required unless `offline` is set. Can be set via the `NETBOX_SERVER_URL` environment variable.",
 			},
 			"api_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
+				ConflictsWith: []string{"api_token_file", "username"},
+				Description:   "Netbox API authentication token. Either this, `api_token_file` or `username` and `password` are required. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+			},
+			"api_token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_API_TOKEN_FILE", ""),
+				ConflictsWith: []string{"api_token", "username"},
+				Description:   "Path to a file containing the Netbox API authentication token. Leading and trailing whitespace is ignored. Can be set via the `NETBOX_API_TOKEN_FILE` environment variable.",
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_USERNAME", ""),
+				ConflictsWith: []string{"api_token", "api_token_file"},
+				RequiredWith:  []string{"password"},
+				Description:   "Name of the Netbox user to authenticate as instead of using a long-lived API token. The provider provisions a token for this user when it starts and deletes it again when Terraform stops the provider. As a safeguard, the token expires after 24 hours. Can be set via the `NETBOX_USERNAME` environment variable.",
+			},
+			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
-				Description: "Netbox API authentication token. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_PASSWORD", ""),
+				Description: "Password of `username`. Can be set via the `NETBOX_PASSWORD` environment variable.",
 			},
 			"allow_insecure_https": {
 				Type:        schema.TypeBool,
@@ -210,48 +289,313 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_ALLOW_INSECURE_HTTPS", false),
 				Description: "Flag to set whether to allow https with invalid certificates. Can be set via the `NETBOX_ALLOW_INSECURE_HTTPS` environment variable. Defaults to `false`.",
 			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_BRANCH", ""),
+				Description: "Name of a branch of the [netbox-branching](https://github.com/netboxlabs/netbox-branching) plugin. If set, all changes are made in this branch instead of main. Resources can override it with their own `branch` attribute. Can be set via the `NETBOX_BRANCH` environment variable.",
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_FILE", ""),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a file with PEM encoded CA certificates that are trusted in addition to the system roots when verifying the Netbox server certificate. Can be set via the `NETBOX_CA_CERT_FILE` environment variable.",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_PEM", ""),
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA certificates that are trusted in addition to the system roots when verifying the Netbox server certificate. Can be set via the `NETBOX_CA_CERT_PEM` environment variable.",
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_FILE", ""),
+				ConflictsWith: []string{"client_cert_pem"},
+				Description:   "Path to a PEM encoded client certificate presented to Netbox for mutual TLS authentication. Can be set via the `NETBOX_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_FILE", ""),
+				ConflictsWith: []string{"client_key_pem"},
+				Description:   "Path to the PEM encoded private key of the client certificate. Can be set via the `NETBOX_CLIENT_KEY_FILE` environment variable.",
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_PEM", ""),
+				ConflictsWith: []string{"client_cert_file"},
+				Description:   "PEM encoded client certificate presented to Netbox for mutual TLS authentication. Can be set via the `NETBOX_CLIENT_CERT_PEM` environment variable.",
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_PEM", ""),
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM encoded private key of the client certificate. Can be set via the `NETBOX_CLIENT_KEY_PEM` environment variable.",
+			},
+			"default_tags": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Names of tags that are added to every resource managed by this provider that supports tags. The tags must already exist in Netbox. Default tags are not shown in the `tags` attribute of resources and should not be repeated there.",
+			},
+			"enable_request_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_ENABLE_REQUEST_CACHE", false),
+				Description: "If true, identical GET requests within one Terraform run are only sent to Netbox once and answered from memory afterwards. Any write request clears the cache. Only enable it if no other tools change Netbox during a run, otherwise resources may read outdated objects. Can be set via the `NETBOX_ENABLE_REQUEST_CACHE` environment variable. Defaults to `false`.",
+			},
+			"disable_keepalives": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_DISABLE_KEEPALIVES", false),
+				Description: "If true, open a new connection for every request to Netbox instead of reusing idle connections. Can be set via the `NETBOX_DISABLE_KEEPALIVES` environment variable. Defaults to `false`.",
+			},
 			"headers": {
 				Type:        schema.TypeMap,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_HEADERS", map[string]interface{}{}),
 				Description: "Set these header on all requests to Netbox. Can be set via the `NETBOX_HEADERS` environment variable.",
 			},
+			"offline": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_OFFLINE", false),
+				Description: "If true, the provider does not connect to Netbox and needs neither `server_url` nor an API token. Every resource or data source that has to call the Netbox API fails with an error, so this is only useful for plans of new resources without refresh, e.g. in CI pipelines without access to Netbox. Can be set via the `NETBOX_OFFLINE` environment variable. Defaults to `false`.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_PROXY_URL", ""),
+				Description: "URL of an HTTP(S) proxy used for all requests to Netbox. If unset, the proxy is taken from the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. Can be set via the `NETBOX_PROXY_URL` environment variable.",
+			},
 			"strip_trailing_slashes_from_url": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_STRIP_TRAILING_SLASHES_FROM_URL", true),
 				Description: "If true, strip trailing slashes from the `server_url` parameter and print a warning when doing so. Note that using trailing slashes in the `server_url` parameter will usually lead to errors. Can be set via the `NETBOX_STRIP_TRAILING_SLASHES_FROM_URL` environment variable. Defaults to `true`.",
 			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_USER_AGENT_SUFFIX", ""),
+				Description: "Text appended to the `terraform-provider-netbox/<version>` User-Agent header sent with every request, e.g. to identify the team or pipeline in the Netbox access logs. Can be set via the `NETBOX_USER_AGENT_SUFFIX` environment variable.",
+			},
+			"minimum_netbox_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MINIMUM_VERSION", ""),
+				ValidateFunc: validateNetboxVersion,
+				Description:  "Oldest Netbox version the provider may be used with, e.g. `4.0` or `4.0.3`. If set, the version check compares against this range instead of the list of versions the provider was tested with. Can be set via the `NETBOX_MINIMUM_VERSION` environment variable.",
+			},
+			"maximum_netbox_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAXIMUM_VERSION", ""),
+				ValidateFunc: validateNetboxVersion,
+				Description:  "Newest Netbox version the provider may be used with. Only the given version segments are compared, so `4.0` allows every `4.0.x` release. If set, the version check compares against this range instead of the list of versions the provider was tested with. Can be set via the `NETBOX_MAXIMUM_VERSION` environment variable.",
+			},
+			"unsupported_version_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_UNSUPPORTED_VERSION_SEVERITY", "warning"),
+				ValidateFunc: validation.StringInSlice([]string{"warning", "error"}, false),
+				Description:  "Whether the version check reports an unsupported Netbox version as `warning` or fails with an `error`. Can be set via the `NETBOX_UNSUPPORTED_VERSION_SEVERITY` environment variable. Defaults to `warning`.",
+			},
 			"skip_version_check": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_SKIP_VERSION_CHECK", false),
-				Description: "If true, do not try to determine the running Netbox version at provider startup. Disables warnings about possibly unsupported Netbox version. Also useful for local testing on terraform plans. Can be set via the `NETBOX_SKIP_VERSION_CHECK` environment variable. Defaults to `false`.",
+				Description: "If true, do not try to determine the running Netbox version at provider startup. Disables warnings about possibly unsupported Netbox version and ignores `minimum_netbox_version` and `maximum_netbox_version`. Also useful for local testing on terraform plans. Can be set via the `NETBOX_SKIP_VERSION_CHECK` environment variable. Defaults to `false`.",
 			},
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
-				Description: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
+				Description: "Netbox API HTTP request timeout in seconds. Requests of resource operations with `timeouts`, like creating a `netbox_device`, are limited by those instead. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
+			},
+			"detect_external_modifications": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_DETECT_EXTERNAL_MODIFICATIONS", false),
+				Description: "If true, updating a resource fails if its object was modified in Netbox since Terraform last read it, instead of overwriting the modification. The `last_updated` of the object in the state is compared to the one in Netbox. Can be set via the `NETBOX_DETECT_EXTERNAL_MODIFICATIONS` environment variable. Defaults to `false`.",
+			},
+			"ignore_unmanaged_custom_fields": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_IGNORE_UNMANAGED_CUSTOM_FIELDS", false),
+				Description: "If true, the `custom_fields` of resources only contain the custom fields declared in the configuration. Custom fields set by other systems are neither shown in the state nor changed. Can be set via the `NETBOX_IGNORE_UNMANAGED_CUSTOM_FIELDS` environment variable. Defaults to `false`.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests that are sent to Netbox at the same time. `0` means no limit. Can be set via the `NETBOX_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0`.",
+			},
+			"max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_IDLE_CONNS", 10),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of idle connections to Netbox that are kept open for reuse. Should be at least the parallelism of Terraform to avoid a new TLS handshake for most requests. Can be set via the `NETBOX_MAX_IDLE_CONNS` environment variable. Defaults to `10`.",
+			},
+			"idle_conn_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_IDLE_CONN_TIMEOUT", 90),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Time in seconds after which idle connections to Netbox are closed. `0` means no limit. Can be set via the `NETBOX_IDLE_CONN_TIMEOUT` environment variable. Defaults to `90`.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_RETRIES", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of times a request is retried when Netbox answers with a rate limit (429) or server error (5xx) response. POST and PATCH requests, which Netbox may already have processed, are only retried on 429, 502, 503 and 504 responses. Retries back off exponentially, starting at `retry_min_delay`. Can be set via the `NETBOX_MAX_RETRIES` environment variable. Defaults to `0`.",
 			},
+			"retry_min_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_RETRY_MIN_DELAY", 1),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Delay in seconds before the first retry of a failed request. The delay doubles with every further retry. Can be set via the `NETBOX_RETRY_MIN_DELAY` environment variable. Defaults to `1`.",
+			},
+		},
+		ConfigureContextFunc: func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			return providerConfigure(ctx, data, options)
 		},
-		ConfigureContextFunc: providerConfigure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		wrapAPIErrors(r, objectPathFunc(name))
+		wrapUnmanagedCustomFields(r)
+		wrapExternalModificationCheck(r)
+		if slices.Contains(deletionPolicyResources, name) {
+			wrapDeletionPolicy(r)
+		}
+		if s, ok := r.Schema["slug"]; ok && s.Optional && s.Computed {
+			sourceKey, ok := slugSourceKeys[name]
+			if !ok {
+				sourceKey = "name"
+			}
+			wrapSlugFromName(r, sourceKey)
+		}
+		if keys, ok := referenceLookups[name]; ok {
+			wrapReferenceLookups(r, keys)
+		}
+		if path, ok := importPaths[name]; ok && !slices.Contains(compositeImportIDs, name) {
+			wrapImportByFilter(r, path)
+		}
+		if keys, ok := resourceIdentities[name]; ok {
+			wrapAdoptExisting(r, importPaths[name], keys)
+			wrapResourceIdentity(r, importPaths[name], keys)
+		}
+		wrapBranch(r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		wrapAPIErrors(r, nil)
+		if list, ok := listDataSources[name]; ok {
+			wrapKeyedOutputs(r, list)
+			wrapFieldSelection(r, list)
+		}
+	}
+
 	return provider
 }
 
-func providerConfigure(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	var diags diag.Diagnostics
+// providerState is the meta of the provider that is passed to all resources
+// and data sources: the API client together with the settings of the provider
+// configuration that apply to all of them.
+type providerState struct {
+	*client.NetBoxAPI
+
+	// defaultTags are merged into the tags of every resource on create and
+	// update.
+	defaultTags                 []string
+	ignoreUnmanagedCustomFields bool
+	detectExternalModifications bool
+
+	// referenceCache and referenceNameCache hold the objects already looked
+	// up by lookupReference and lookupReferenceName.
+	referenceCache     sync.Map
+	referenceNameCache sync.Map
+
+	// branches holds the states used by resources with their own branch, see
+	// forBranch.
+	branches sync.Map
+
+	// sessionToken is the token provisioned for username and password
+	// authentication, which is revoked when the provider stops, see Serve.
+	sessionToken   *provisionedToken
+	sessionTokenMu sync.Mutex
+}
+
+// newProviderState returns the state of a provider that uses the given client
+// and the settings of the given provider configuration.
+func newProviderState(api *client.NetBoxAPI, data *schema.ResourceData) *providerState {
+	return &providerState{
+		NetBoxAPI:                   api,
+		defaultTags:                 toStringList(data.Get("default_tags")),
+		ignoreUnmanagedCustomFields: data.Get("ignore_unmanaged_custom_fields").(bool),
+		detectExternalModifications: data.Get("detect_external_modifications").(bool),
+	}
+}
+
+func providerConfigure(ctx context.Context, data *schema.ResourceData, options providerOptions) (meta interface{}, diags diag.Diagnostics) {
+	if data.Get("offline").(bool) {
+		return newProviderState(offlineClient(), data), diags
+	}
 
 	config := Config{
 		APIToken:                    data.Get("api_token").(string),
+		Username:                    data.Get("username").(string),
+		Password:                    data.Get("password").(string),
 		AllowInsecureHTTPS:          data.Get("allow_insecure_https").(bool),
+		CACertFile:                  data.Get("ca_cert_file").(string),
+		CACertPEM:                   data.Get("ca_cert_pem").(string),
+		ClientCertFile:              data.Get("client_cert_file").(string),
+		ClientKeyFile:               data.Get("client_key_file").(string),
+		ClientCertPEM:               data.Get("client_cert_pem").(string),
+		ClientKeyPEM:                data.Get("client_key_pem").(string),
 		Headers:                     data.Get("headers").(map[string]interface{}),
+		ProxyURL:                    data.Get("proxy_url").(string),
 		RequestTimeout:              data.Get("request_timeout").(int),
+		MaxRetries:                  data.Get("max_retries").(int),
+		MaxConcurrentRequests:       data.Get("max_concurrent_requests").(int),
+		RetryMinDelay:               data.Get("retry_min_delay").(int),
+		EnableRequestCache:          data.Get("enable_request_cache").(bool),
+		MaxIdleConns:                data.Get("max_idle_conns").(int),
+		IdleConnTimeout:             data.Get("idle_conn_timeout").(int),
+		DisableKeepAlives:           data.Get("disable_keepalives").(bool),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
+		TransportWrapper:            options.transportWrapper,
+	}
+
+	config.UserAgent = fmt.Sprintf("terraform-provider-netbox/%s", Version)
+	if suffix := data.Get("user_agent_suffix").(string); suffix != "" {
+		config.UserAgent += " " + suffix
+	}
+
+	if tokenFile := data.Get("api_token_file").(string); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, diag.Errorf("error while trying to read API token file: %s", err)
+		}
+		config.APIToken = strings.TrimSpace(string(token))
 	}
 
 	serverURL := data.Get("server_url").(string)
+	if serverURL == "" {
+		return nil, diag.Errorf("server_url must be set unless the provider is configured with offline = true")
+	}
 
 	// Unless explicitly switched off, strip trailing slashes from the server url
 	// Trailing slashes cause errors as seen in https://github.com/e-breuninger/terraform-provider-netbox/issues/198
@@ -276,11 +620,30 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 	}
 
 	config.ServerURL = serverURL
+	config.Context = ctx
 
 	netboxClient, clientError := config.Client()
 	if clientError != nil {
 		return nil, diag.FromErr(clientError)
 	}
+	if config.provisionedToken != nil {
+		// Nothing else revokes the token if the provider cannot be configured
+		defer func() {
+			if diags.HasError() {
+				(&providerState{sessionToken: config.provisionedToken}).revokeSessionToken()
+			}
+		}()
+	}
+
+	// The branching plugin identifies branches by their schema ID, so look it up
+	// and send it with every request
+	if branch := data.Get("branch").(string); branch != "" {
+		schemaID, err := getBranchSchemaID(&providerState{NetBoxAPI: netboxClient}, branch)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		netboxClient = branchClient(netboxClient, schemaID)
+	}
 
 	// Unless explicitly switched off, use the client to retrieve the Netbox version
 	// so we can determine compatibility of the provider with the used Netbox
@@ -295,17 +658,110 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 
 		netboxVersion := res.GetPayload().(map[string]interface{})["netbox-version"].(string)
 
-		supportedVersions := []string{"4.0.0", "4.0.1", "4.0.2", "4.0.3", "4.0.5", "4.0.6", "4.0.7", "4.0.8", "4.0.9", "4.0.10", "4.0.11"}
+		severity := diag.Warning
+		if data.Get("unsupported_version_severity").(string) == "error" {
+			severity = diag.Error
+		}
 
-		if !slices.Contains(supportedVersions, netboxVersion) {
-			// Currently, there is no way to test these warnings. There is an issue to track this: https://github.com/hashicorp/terraform-plugin-sdk/issues/864
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Possibly unsupported Netbox version",
-				Detail:   fmt.Sprintf("Your Netbox version is v%v. The provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersion, strings.Join(supportedVersions, ", ")),
-			})
+		versionDiags := checkNetboxVersion(netboxVersion, data.Get("minimum_netbox_version").(string), data.Get("maximum_netbox_version").(string), severity)
+		diags = append(diags, versionDiags...)
+		if versionDiags.HasError() {
+			return nil, diags
+		}
+	}
+
+	state := newProviderState(netboxClient, data)
+	state.sessionToken = config.provisionedToken
+	return state, diags
+}
+
+// getBranchSchemaID returns the schema ID of the netbox-branching branch with
+// the given name.
+func getBranchSchemaID(api *providerState, name string) (string, error) {
+	res, err := apiRequest(api, "GET", "/plugins/branching/branches/", url.Values{"name": {name}}, nil)
+	if err != nil {
+		return "", fmt.Errorf("error while trying to look up branch %s: %s", name, err)
+	}
+
+	results, _ := res.(map[string]interface{})["results"].([]interface{})
+	if len(results) != 1 {
+		return "", fmt.Errorf("expected exactly one branch named %s, found %d", name, len(results))
+	}
+
+	schemaID, ok := results[0].(map[string]interface{})["schema_id"].(string)
+	if !ok {
+		return "", fmt.Errorf("branch %s has no schema ID", name)
+	}
+	return schemaID, nil
+}
+
+// supportedVersions are the Netbox versions the provider was tested against.
+var supportedVersions = []string{"4.0.0", "4.0.1", "4.0.2", "4.0.3", "4.0.5", "4.0.6", "4.0.7", "4.0.8", "4.0.9", "4.0.10", "4.0.11"}
+
+// checkNetboxVersion reports the given Netbox version with the given severity
+// if it lies outside of the configured minimum and maximum version. Without a
+// configured range, the version must be one of supportedVersions.
+func checkNetboxVersion(netboxVersion, minimum, maximum string, severity diag.Severity) diag.Diagnostics {
+	if minimum == "" && maximum == "" {
+		if slices.Contains(supportedVersions, netboxVersion) {
+			return nil
+		}
+		// Currently, there is no way to test these warnings. There is an issue to track this: https://github.com/hashicorp/terraform-plugin-sdk/issues/864
+		return diag.Diagnostics{{
+			Severity: severity,
+			Summary:  "Possibly unsupported Netbox version",
+			Detail:   fmt.Sprintf("Your Netbox version is v%v. The provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersion, strings.Join(supportedVersions, ", ")),
+		}}
+	}
+
+	current, err := version.NewVersion(netboxVersion)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: severity,
+			Summary:  "Unable to parse Netbox version",
+			Detail:   fmt.Sprintf("The Netbox version %q could not be compared with the configured version range: %s", netboxVersion, err),
+		}}
+	}
+	// Ignore suffixes like -Docker-2.9.1 that some distributions append
+	current = current.Core()
+
+	if minimum != "" {
+		minVersion, _ := version.NewVersion(minimum)
+		if current.LessThan(minVersion) {
+			return diag.Diagnostics{{
+				Severity: severity,
+				Summary:  "Unsupported Netbox version",
+				Detail:   fmt.Sprintf("Your Netbox version is v%v, but at least v%v is required by `minimum_netbox_version`.", netboxVersion, minimum),
+			}}
 		}
 	}
 
-	return netboxClient, diags
+	if maximum != "" {
+		maxVersion, _ := version.NewVersion(maximum)
+		// Only compare the segments given in the maximum version, so 4.0 includes 4.0.11
+		currentSegments, maxSegments := current.Segments(), maxVersion.Segments()
+		exceeded := false
+		for i := 0; i < len(strings.Split(maximum, ".")) && i < len(maxSegments); i++ {
+			if currentSegments[i] != maxSegments[i] {
+				exceeded = currentSegments[i] > maxSegments[i]
+				break
+			}
+		}
+		if exceeded {
+			return diag.Diagnostics{{
+				Severity: severity,
+				Summary:  "Unsupported Netbox version",
+				Detail:   fmt.Sprintf("Your Netbox version is v%v, but at most v%v is allowed by `maximum_netbox_version`.", netboxVersion, maximum),
+			}}
+		}
+	}
+
+	return nil
+}
+
+func validateNetboxVersion(v interface{}, k string) ([]string, []error) {
+	if _, err := version.NewVersion(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a version like 4.0 or 4.0.3: %s", k, err)}
+	}
+	return nil, nil
 }
@@ -3,11 +3,16 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,11 +23,24 @@ var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testPrefix = "test"
 
+// testAccProtoV5ProviderFactories serve the muxed provider, which is needed
+// by tests that use resources implemented with the framework.
+var testAccProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
+
 func init() {
 	testAccProvider = Provider()
 	testAccProviders = map[string]*schema.Provider{
 		"netbox": testAccProvider,
 	}
+	testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+		"netbox": func() (tfprotov5.ProviderServer, error) {
+			factory, err := muxProviderServer(context.Background(), testAccProvider)
+			if err != nil {
+				return nil, err
+			}
+			return factory(), nil
+		},
+	}
 }
 
 func testAccGetTestName(testSlug string) string {
@@ -64,7 +82,7 @@ func providerInvalidConfigure() schema.ConfigureContextFunc {
 			return nil, diag.FromErr(clientError)
 		}
 
-		return netboxClient, diags
+		return &providerState{NetBoxAPI: netboxClient}, diags
 	}
 }
 
@@ -87,3 +105,230 @@ func TestAccNetboxProviderConfigure_failure(t *testing.T) {
 		},
 	})
 }
+
+func TestProviderConfigureAPITokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("07b12b765127747e4afd56cb531b7bf9c61f3c30\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url":         "https://localhost:8080",
+		"api_token_file":     tokenFile,
+		"skip_version_check": true,
+	})
+
+	client, diags := providerConfigure(context.Background(), data, providerOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if client == nil {
+		t.Fatal("expected a client")
+	}
+}
+
+func TestProviderConfigureMissingAPITokenFile(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url":         "https://localhost:8080",
+		"api_token_file":     filepath.Join(t.TempDir(), "missing"),
+		"skip_version_check": true,
+	})
+
+	_, diags := providerConfigure(context.Background(), data, providerOptions{})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing token file")
+	}
+}
+
+func TestProviderConfigureBranch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/plugins/branching/branches/":
+			if r.URL.Query().Get("name") != "staging" {
+				t.Errorf("unexpected branch lookup %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"count": 1, "results": [{"id": 1, "name": "staging", "schema_id": "td5smq0f"}]}`))
+		default:
+			if got := r.Header.Get(branchHeader); got != "td5smq0f" {
+				t.Errorf("expected branch header td5smq0f, got %q", got)
+			}
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"server_url":         ts.URL,
+		"api_token":          "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		"branch":             "staging",
+		"skip_version_check": true,
+	})
+
+	api, diags := providerConfigure(context.Background(), data, providerOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if _, err := apiRequest(api.(*providerState), "GET", "/dcim/sites/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderConfigureOffline(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"offline": true,
+	})
+
+	api, diags := providerConfigure(context.Background(), data, providerOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	_, err := apiRequest(api.(*providerState), "GET", "/dcim/sites/", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "offline = true") {
+		t.Fatalf("expected offline error, got %v", err)
+	}
+}
+
+func TestProviderConfigureMissingServerURL(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_token": "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+	})
+
+	_, diags := providerConfigure(context.Background(), data, providerOptions{})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing server_url")
+	}
+}
+
+func TestCheckNetboxVersion(t *testing.T) {
+	for _, tc := range []struct {
+		version, minimum, maximum string
+		expectDiag                bool
+	}{
+		{version: "4.0.11"},
+		{version: "4.1.0", expectDiag: true},
+		{version: "4.1.0", minimum: "4.0"},
+		{version: "3.7.8", minimum: "4.0", expectDiag: true},
+		{version: "4.0.11", maximum: "4.0"},
+		{version: "4.0.11-Docker-2.9.1", maximum: "4.0"},
+		{version: "4.1.0", maximum: "4.0", expectDiag: true},
+		{version: "4.0.4", maximum: "4.0.3", expectDiag: true},
+		{version: "4.0.3", minimum: "4.0.3", maximum: "4.0.3"},
+		{version: "5.0.0", minimum: "4.0", maximum: "4", expectDiag: true},
+	} {
+		diags := checkNetboxVersion(tc.version, tc.minimum, tc.maximum, diag.Error)
+		if diags.HasError() != tc.expectDiag {
+			t.Errorf("version %s, minimum %q, maximum %q: expected diagnostic %t, got %v", tc.version, tc.minimum, tc.maximum, tc.expectDiag, diags)
+		}
+	}
+}
+
+func TestProviderConfigureUnsupportedVersion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.1.0"}`))
+	}))
+	defer ts.Close()
+
+	for severity, expectError := range map[string]bool{"warning": false, "error": true} {
+		data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"server_url":                   ts.URL,
+			"api_token":                    "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			"maximum_netbox_version":       "4.0",
+			"unsupported_version_severity": severity,
+		})
+
+		_, diags := providerConfigure(context.Background(), data, providerOptions{})
+		if len(diags) != 1 {
+			t.Fatalf("severity %s: expected one diagnostic, got %v", severity, diags)
+		}
+		if diags.HasError() != expectError {
+			t.Errorf("severity %s: expected error %t, got %v", severity, expectError, diags)
+		}
+	}
+}
+
+func TestProviderServerSchema(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if _, ok := resp.Functions["slugify"]; !ok {
+		t.Error("expected function slugify")
+	}
+	if _, ok := resp.EphemeralResourceSchemas["netbox_available_ip_address"]; !ok {
+		t.Error("expected ephemeral resource netbox_available_ip_address")
+	}
+}
+
+func TestProviderServerServesSDKCatalog(t *testing.T) {
+	providerServer, err := ProviderServer(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := providerServer().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sdkProvider := Provider()
+	for name := range sdkProvider.ResourcesMap {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("expected resource %s", name)
+		}
+	}
+	for name := range sdkProvider.DataSourcesMap {
+		if _, ok := resp.DataSourceSchemas[name]; !ok {
+			t.Errorf("expected data source %s", name)
+		}
+	}
+}
+
+func TestProviderServerConfiguresClientOnce(t *testing.T) {
+	statusRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/status/" {
+			statusRequests++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.0.11"}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	factory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := testDynamicValue(t, schemaResp.Provider, map[string]tftypes.Value{
+		"server_url": tftypes.NewValue(tftypes.String, ts.URL),
+		"api_token":  tftypes.NewValue(tftypes.String, "07b12b765127747e4afd56cb531b7bf9c61f3c30"),
+	})
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if statusRequests != 1 {
+		t.Errorf("expected one version check, got %d", statusRequests)
+	}
+}
@@ -0,0 +1,207 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+)
+
+// reference describes the objects that `_id` attributes with a common prefix
+// reference, e.g. site_id references a site.
+type reference struct {
+	// label is used in descriptions and error messages.
+	label string
+	// path is the API endpoint of the referenced objects.
+	path string
+	// filters are the API filters tried in order to look up an object by
+	// name or slug.
+	filters []string
+}
+
+// lookupFields describes the fields the object is looked up by, like
+// "name or slug".
+func (ref reference) lookupFields() string {
+	fields := slices.Clone(ref.filters)
+	slices.Reverse(fields)
+	return strings.Join(fields, " or ")
+}
+
+// references are the objects that `_id` attributes and resource identities
+// refer to, keyed by the prefix of the `_id` attribute.
+var references = map[string]reference{
+	"cluster":      {"cluster", "/virtualization/clusters/", []string{"name"}},
+	"manufacturer": {"manufacturer", "/dcim/manufacturers/", []string{"slug", "name"}},
+	"platform":     {"platform", "/dcim/platforms/", []string{"slug", "name"}},
+	"rir":          {"RIR", "/ipam/rirs/", []string{"slug", "name"}},
+	"site":         {"site", "/dcim/sites/", []string{"slug", "name"}},
+	"vrf":          {"VRF", "/ipam/vrfs/", []string{"name"}},
+}
+
+// referenceLookups are the resources that get `_name` attributes, with the
+// keys of references they get them for. The `_id` attributes of these
+// resources become computed. Only objects whose name and slug are unique in
+// all of Netbox are looked up this way, so that a lookup can not become
+// ambiguous later. Clusters, VRFs, racks, locations, regions, tenants, device
+// types and rack types are only unique within their scope, if at all.
+var referenceLookups = map[string][]string{
+	"netbox_aggregate":       {"rir"},
+	"netbox_asn":             {"rir"},
+	"netbox_cluster":         {"site"},
+	"netbox_device":          {"platform", "site"},
+	"netbox_device_type":     {"manufacturer"},
+	"netbox_location":        {"site"},
+	"netbox_module_type":     {"manufacturer"},
+	"netbox_platform":        {"manufacturer"},
+	"netbox_power_panel":     {"site"},
+	"netbox_prefix":          {"site"},
+	"netbox_rack":            {"site"},
+	"netbox_rack_type":       {"manufacturer"},
+	"netbox_virtual_machine": {"platform", "site"},
+	"netbox_vlan":            {"site"},
+}
+
+// wrapReferenceLookups adds a `_name` attribute for the `_id` attribute of
+// the given resource with each of the given keys of references. The object is
+// looked up by the name or slug at plan time and its ID is planned for the
+// `_id` attribute, which becomes computed.
+func wrapReferenceLookups(r *schema.Resource, keys []string) {
+	lookups := make(map[string]reference)
+	for _, key := range keys {
+		ref := references[key]
+		idKey, nameKey := key+"_id", key+"_name"
+		s, ok := r.Schema[idKey]
+		if !ok || s.Type != schema.TypeInt || s.Computed || s.Default != nil || r.Schema[nameKey] != nil {
+			continue
+		}
+
+		if s.Required {
+			s.Required = false
+			s.Optional = true
+			s.ExactlyOneOf = []string{idKey}
+		}
+		s.Computed = true
+		r.Schema[nameKey] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{idKey},
+			ExactlyOneOf:  slices.Clone(s.ExactlyOneOf),
+			AtLeastOneOf:  slices.Clone(s.AtLeastOneOf),
+			Description:   fmt.Sprintf("The %s of the %s. It is looked up when planning and can be used instead of `%s`.", ref.lookupFields(), ref.label, idKey),
+		}
+		// The name satisfies every constraint that the ID satisfies.
+		for _, other := range r.Schema {
+			if slices.Contains(other.ExactlyOneOf, idKey) {
+				other.ExactlyOneOf = append(other.ExactlyOneOf, nameKey)
+			}
+			if slices.Contains(other.AtLeastOneOf, idKey) {
+				other.AtLeastOneOf = append(other.AtLeastOneOf, nameKey)
+			}
+		}
+		lookups[key] = ref
+	}
+	if len(lookups) == 0 {
+		return
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+		for key, ref := range lookups {
+			idKey, nameKey := key+"_id", key+"_name"
+			name := config.GetAttr(nameKey)
+			switch {
+			case !name.IsKnown():
+				if err := d.SetNewComputed(idKey); err != nil {
+					return err
+				}
+			case !name.IsNull():
+				api, ok := m.(*providerState)
+				if !ok {
+					return fmt.Errorf("%s can not be looked up without a connection to Netbox", nameKey)
+				}
+				id, err := lookupReference(api, ref, name.AsString())
+				if err != nil {
+					return fmt.Errorf("%s: %w", nameKey, err)
+				}
+				if err := d.SetNew(idKey, int(id)); err != nil {
+					return err
+				}
+			case config.GetAttr(idKey).IsNull() && d.Get(idKey).(int) != 0:
+				// Neither attribute is configured, so the reference is
+				// removed like it was before the `_id` attribute became
+				// computed.
+				if err := d.SetNew(idKey, 0); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+type referenceCacheKey struct {
+	path, name string
+}
+
+// lookupReference returns the ID of the object of the given reference with
+// the given name or slug.
+func lookupReference(api *providerState, ref reference, name string) (int64, error) {
+	cacheKey := referenceCacheKey{ref.path, name}
+	if id, ok := api.referenceCache.Load(cacheKey); ok {
+		return id.(int64), nil
+	}
+
+	for _, filter := range ref.filters {
+		id, err := getIDByQuery(api, ref.path, url.Values{filter: {name}}, filter+"="+name)
+		if errors.Is(err, errNoObjectFound) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		api.referenceCache.Store(cacheKey, id)
+		return id, nil
+	}
+	return 0, fmt.Errorf("no %s found with %s %q", ref.label, ref.lookupFields(), name)
+}
+
+type referenceNameCacheKey struct {
+	path string
+	id   int64
+}
+
+// lookupReferenceName returns the name or slug, whichever the object of the
+// given reference is looked up by first, of the object with the given ID.
+func lookupReferenceName(api *providerState, ref reference, id int64) (string, error) {
+	cacheKey := referenceNameCacheKey{ref.path, id}
+	if name, ok := api.referenceNameCache.Load(cacheKey); ok {
+		return name.(string), nil
+	}
+
+	res, err := apiRequest(api, http.MethodGet, fmt.Sprintf("%s%d/", ref.path, id), nil, nil)
+	if err != nil {
+		return "", err
+	}
+	object, _ := res.(map[string]interface{})
+	name, ok := object[ref.filters[0]].(string)
+	if !ok {
+		return "", fmt.Errorf("the %s with ID %d has no %s", ref.label, id, ref.filters[0])
+	}
+	api.referenceNameCache.Store(cacheKey, name)
+	return name, nil
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapReferenceLookups(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/dcim/sites/", r.URL.Path)
+		if r.URL.Query().Get("slug") == "frankfurt-1" || r.URL.Query().Get("name") == "Frankfurt 1" {
+			w.Write([]byte(`{"count": 1, "results": [{"id": 17}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+	wrapReferenceLookups(r, []string{"site"})
+	assert.Contains(t, r.Schema, "site_name")
+	assert.True(t, r.Schema["site_id"].Optional)
+	assert.True(t, r.Schema["site_id"].Computed)
+	assert.Equal(t, []string{"site_id", "site_name"}, r.Schema["site_id"].ExactlyOneOf)
+
+	for _, tc := range []struct {
+		name     string
+		siteName string
+		expected string
+		err      bool
+	}{
+		{"Slug", "frankfurt-1", "17", false},
+		{"Name", "Frankfurt 1", "17", false},
+		{"Cached", "frankfurt-1", "17", false},
+		{"Unknown", "berlin-1", "", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"site_id":   cty.NullVal(cty.Number),
+				"site_name": cty.StringVal(tc.siteName),
+			})}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"site_name": tc.siteName}), api)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, diff.Attributes["site_id"].New)
+		})
+	}
+	// frankfurt-1 is found by slug, Frankfurt 1 only by name after the slug
+	// did not match and berlin-1 by neither.
+	assert.Equal(t, 5, requests)
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,11 +20,12 @@ func resourceNetboxAggregate() *schema.Resource {
 
 > NetBox allows us to specify the portions of IP space that are interesting to us by defining aggregates. Typically, an aggregate will correspond to either an allocation of public (globally routable) IP space granted by a regional authority, or a private (internally-routable) designation.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"prefix": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsCIDR,
+				DiffSuppressFunc: diffSuppressIPAddress,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -35,22 +35,25 @@ func resourceNetboxAggregate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"rir_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			"rir":           relatedObjectSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableAggregate{}
 
-	prefix := d.Get("prefix").(string)
+	prefix := normalizeIPAddress(d.Get("prefix").(string))
 	description := d.Get("description").(string)
 
 	data.Prefix = &prefix
@@ -66,6 +69,10 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamAggregatesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamAggregatesCreate(params, nil)
 	if err != nil {
@@ -77,7 +84,7 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAggregatesReadParams().WithID(id)
 
@@ -104,23 +111,31 @@ func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(res.GetPayload().Tenant))
 
 	if res.GetPayload().Rir != nil {
 		d.Set("rir_id", res.GetPayload().Rir.ID)
 	} else {
 		d.Set("rir_id", nil)
 	}
+	d.Set("rir", getRelatedObject(res.GetPayload().Rir))
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableAggregate{}
-	prefix := d.Get("prefix").(string)
+	prefix := normalizeIPAddress(d.Get("prefix").(string))
 	description := d.Get("description").(string)
 
 	data.Prefix = &prefix
@@ -136,8 +151,12 @@ func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamAggregatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamAggregatesUpdate(params, nil)
+	_, err := api.Ipam.IpamAggregatesUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -145,7 +164,7 @@ func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxAggregateDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAggregatesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamAggregatesDelete(params, nil)
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +20,7 @@ func resourceNetboxAsn() *schema.Resource {
 >
 > The AS number model within NetBox allows you to model some of this real-world relationship.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"asn": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -30,8 +29,9 @@ func resourceNetboxAsn() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -39,7 +39,7 @@ func resourceNetboxAsn() *schema.Resource {
 }
 
 func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableASN{}
 
@@ -51,6 +51,10 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamAsnsCreateParams().WithData(&data)
 
 	res, err := api.Ipam.IpamAsnsCreate(params, nil)
@@ -64,7 +68,7 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAsnsReadParams().WithID(id)
 
@@ -86,13 +90,19 @@ func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("asn", asn.Asn)
 	d.Set("rir_id", asn.Rir.ID)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(asn.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, asn.Tags))
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableASN{}
@@ -105,6 +115,10 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Ipam.IpamAsnsUpdate(params, nil)
@@ -116,7 +130,7 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxAsnDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamAsnsDeleteParams().WithID(id)
@@ -1,9 +1,10 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
+	"time"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,10 +13,10 @@ import (
 
 func resourceNetboxAvailableIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailableIPAddressCreate,
-		Read:   resourceNetboxAvailableIPAddressRead,
-		Update: resourceNetboxAvailableIPAddressUpdate,
-		Delete: resourceNetboxAvailableIPAddressDelete,
+		CreateContext: withAllocationRetry(legacyContextFunc(resourceNetboxAvailableIPAddressCreate)),
+		Read:          resourceNetboxAvailableIPAddressRead,
+		Update:        resourceNetboxAvailableIPAddressUpdate,
+		Delete:        resourceNetboxAvailableIPAddressDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/ipaddress/):
 
@@ -31,7 +32,7 @@ func resourceNetboxAvailableIPAddress() *schema.Resource {
 
 This resource will retrieve the next available IP address from a given prefix or IP range (specified by ID)`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"prefix_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -91,22 +92,26 @@ This resource will retrieve the next available IP address from a given prefix or
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
 			"role": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
 func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	prefixID := int64(d.Get("prefix_id").(int))
 	vrfID := int64(int64(d.Get("vrf_id").(int)))
 	rangeID := int64(d.Get("ip_range_id").(int))
@@ -116,16 +121,28 @@ func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{
 	data := models.AvailableIP{
 		Vrf: &nestedvrf,
 	}
+
+	// Finding a free address in a large prefix or range can take longer than
+	// the request timeout
+	ctx, cancel := withOperationTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	if prefixID != 0 {
-		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData([]*models.AvailableIP{&data}).WithContext(ctx).WithTimeout(d.Timeout(schema.TimeoutCreate))
+		res, err := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return err
+		}
 		// Since we generated the ip_address, set that now
 		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
 		d.Set("ip_address", *res.Payload[0].Address)
 	}
 	if rangeID != 0 {
-		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeID).WithData([]*models.AvailableIP{&data}).WithContext(ctx).WithTimeout(d.Timeout(schema.TimeoutCreate))
+		res, err := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return err
+		}
 		// Since we generated the ip_address, set that now
 		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
 		d.Set("ip_address", *res.Payload[0].Address)
@@ -134,7 +151,7 @@ func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
 
@@ -191,12 +208,19 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 	d.Set("ip_address", ipAddress.Address)
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
-	d.Set(tagsKey, getTagListFromNestedTagList(ipAddress.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, ipAddress.Tags))
+
+	cf := getCustomFields(api, ipAddress.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPAddress{}
@@ -239,6 +263,10 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
@@ -249,7 +277,7 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
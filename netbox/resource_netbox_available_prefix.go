@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +15,14 @@ import (
 
 func resourceNetboxAvailablePrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailablePrefixCreate,
-		Read:   resourceNetboxPrefixRead,
-		Update: resourceNetboxPrefixUpdate,
-		Delete: resourceNetboxPrefixDelete,
+		CreateContext: withAllocationRetry(legacyContextFunc(resourceNetboxAvailablePrefixCreate)),
+		Read:          resourceNetboxPrefixRead,
+		Update:        resourceNetboxPrefixUpdate,
+		Delete:        resourceNetboxPrefixDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"parent_prefix_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -76,11 +76,22 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				parentPrefixID, prefixID, prefixLength, err := resourceNetboxAvailablePrefixParseImport(rd.Id())
+				// The parent prefix and the prefix can be given by filters
+				parts := strings.SplitN(rd.Id(), " ", 3)
+				for i := 0; i < len(parts) && i < 2; i++ {
+					id, err := importIDByFilter(meta, importPaths["netbox_available_prefix"], parts[i])
+					if err != nil {
+						return nil, err
+					}
+					parts[i] = id
+				}
+
+				parentPrefixID, prefixID, prefixLength, err := resourceNetboxAvailablePrefixParseImport(strings.Join(parts, " "))
 				if err != nil {
 					return nil, err
 				}
@@ -92,6 +103,9 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				return []*schema.ResourceData{rd}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -115,14 +129,19 @@ func resourceNetboxAvailablePrefixParseImport(importStr string) (int, string, in
 }
 
 func resourceNetboxAvailablePrefixCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	parentPrefixID := int64(d.Get("parent_prefix_id").(int))
 	prefixLength := int64(d.Get("prefix_length").(int))
 	data := models.PrefixLength{
 		PrefixLength: &prefixLength,
 	}
-	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data)
+	// Finding a free prefix in a large parent prefix can take longer than the
+	// request timeout
+	ctx, cancel := withOperationTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data).WithContext(ctx).WithTimeout(d.Timeout(schema.TimeoutCreate))
 
 	res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
 	if err != nil {
@@ -1,9 +1,10 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,11 +18,15 @@ func resourceNetboxCable() *schema.Resource {
 		Update: resourceNetboxCableUpdate,
 		Delete: resourceNetboxCableDelete,
 
+		CustomizeDiff: validateCableTerminations,
+
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/cable/):
 
-> All connections between device components in NetBox are represented using cables. A cable represents a direct physical connection between two sets of endpoints (A and B), such as a console port and a patch panel port, or between two network interfaces.`,
+> All connections between device components in NetBox are represented using cables. A cable represents a direct physical connection between two sets of endpoints (A and B), such as a console port and a patch panel port, or between two network interfaces.
+
+Each end of a cable can have multiple terminations, e.g. the ports of a breakout cable, but they must all be of the same object type.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"a_termination": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -57,8 +62,9 @@ func resourceNetboxCable() *schema.Resource {
 				Optional: true,
 			},
 			"color_hex": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateColorHex,
 			},
 			"length": {
 				Type:     schema.TypeFloat,
@@ -82,15 +88,34 @@ func resourceNetboxCable() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// validateCableTerminations checks that all terminations of a cable end have
+// the same object type, which Netbox requires.
+func validateCableTerminations(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{"a_termination", "b_termination"} {
+		objectType := ""
+		for _, termination := range d.Get(key).(*schema.Set).List() {
+			terminationType := termination.(map[string]interface{})["object_type"].(string)
+			if terminationType == "" {
+				continue
+			}
+			if objectType != "" && terminationType != objectType {
+				return fmt.Errorf("all terminations of %s must have the same object_type, got %s and %s", key, objectType, terminationType)
+			}
+			objectType = terminationType
+		}
+	}
+	return nil
+}
+
 func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableCable{
 		Status:      d.Get("status").(string),
@@ -114,7 +139,7 @@ func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimCablesCreateParams().WithData(&data)
@@ -130,7 +155,7 @@ func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimCablesReadParams().WithID(id)
 
@@ -178,17 +203,18 @@ func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", cable.Description)
 	d.Set("comments", cable.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -214,12 +240,12 @@ func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimCablesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimCablesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimCablesPartialUpdate(params, nil, withClearedStrings(d, "comments", "description", "label"))
 	if err != nil {
 		return err
 	}
@@ -228,7 +254,7 @@ func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxCableDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimCablesDeleteParams().WithID(id)
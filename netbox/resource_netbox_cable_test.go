@@ -2,11 +2,11 @@ package netbox
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -202,9 +202,89 @@ resource "netbox_cable" "test" {
 	})
 }
 
+func TestAccNetboxCable_powerFeed(t *testing.T) {
+	testSlug := "cable_power_feed"
+	testName := testAccGetTestName(testSlug)
+	dependencies := testAccNetboxCableFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_power_panel" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_power_feed" "test" {
+  power_panel_id = netbox_power_panel.test.id
+  name = "%[1]s"
+  status = "active"
+  type = "primary"
+  supply = "ac"
+  phase = "single-phase"
+  voltage = 230
+  amperage = 16
+  max_percent_utilization = 80
+}
+
+resource "netbox_device_power_port" "test" {
+  device_id = netbox_device.test.id
+  name = "%[1]s"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckCableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.powerfeed"
+    object_id = netbox_power_feed.test.id
+  }
+  a_termination {
+    object_type = "dcim.consoleserverport"
+    object_id = netbox_device_console_server_port.test1.id
+  }
+  b_termination {
+    object_type = "dcim.powerport"
+    object_id = netbox_device_power_port.test.id
+  }
+  status = "connected"
+  label = "%[1]s"
+}`, testName),
+				ExpectError: regexp.MustCompile("all terminations of a_termination must have the same object_type"),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.powerfeed"
+    object_id = netbox_power_feed.test.id
+  }
+  b_termination {
+    object_type = "dcim.powerport"
+    object_id = netbox_device_power_port.test.id
+  }
+  status = "connected"
+  type = "power"
+  label = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.test", "a_termination.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "a_termination.0.object_type", "dcim.powerfeed"),
+					resource.TestCheckResourceAttrPair("netbox_cable.test", "a_termination.0.object_id", "netbox_power_feed.test", "id"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "b_termination.#", "1"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "b_termination.0.object_type", "dcim.powerport"),
+					resource.TestCheckResourceAttrPair("netbox_cable.test", "b_termination.0.object_id", "netbox_device_power_port.test", "id"),
+					resource.TestCheckResourceAttr("netbox_cable.test", "type", "power"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCableDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each cable
 	// is destroyed
@@ -244,7 +324,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimCablesListParams()
 			res, err := api.Dcim.DcimCablesList(params, nil)
 			if err != nil {
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,7 +24,7 @@ func resourceNetboxCircuit() *schema.Resource {
 >
 > Each circuit is associated with a provider and a user-defined type. For example, you might have Internet access circuits delivered to each site by one provider, and private MPLS circuits delivered by another. Each circuit must be assigned a circuit ID, each of which must be unique per provider.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"provider_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -48,7 +47,9 @@ func resourceNetboxCircuit() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(resourceNetboxCircuitStatusOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxCircuitStatusOptions),
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -56,7 +57,7 @@ func resourceNetboxCircuit() *schema.Resource {
 }
 
 func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableCircuit{}
 
@@ -80,7 +81,12 @@ func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
 		data.Tenant = int64ToPtr(int64(tenantIDValue.(int)))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := circuits.NewCircuitsCircuitsCreateParams().WithData(&data)
 
@@ -95,7 +101,7 @@ func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitsReadParams().WithID(id)
 
@@ -134,11 +140,19 @@ func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", nil)
 	}
 
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableCircuit{}
@@ -163,7 +177,12 @@ func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Tenant = int64ToPtr(int64(tenantIDValue.(int)))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := circuits.NewCircuitsCircuitsPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -176,7 +195,7 @@ func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxCircuitDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitsDeleteParams().WithID(id)
@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxCircuitProvider() *schema.Resource {
@@ -23,7 +21,7 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 >
 > Each provider may be assigned an autonomous system number (ASN), an account number, and contact information.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,9 +30,11 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -42,7 +42,7 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 }
 
 func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableProvider{}
 
@@ -57,9 +57,14 @@ func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{})
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 	data.Asns = []int64{}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := circuits.NewCircuitsProvidersCreateParams().WithData(&data)
 
 	res, err := api.Circuits.CircuitsProvidersCreate(params, nil)
@@ -73,7 +78,7 @@ func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsProvidersReadParams().WithID(id)
 
@@ -94,11 +99,19 @@ func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) er
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableProvider{}
@@ -114,9 +127,14 @@ func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{})
 		data.Slug = strToPtr(slugValue.(string))
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 	data.Asns = []int64{}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := circuits.NewCircuitsProvidersPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Circuits.CircuitsProvidersPartialUpdate(params, nil)
@@ -128,7 +146,7 @@ func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxCircuitProviderDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsProvidersDeleteParams().WithID(id)
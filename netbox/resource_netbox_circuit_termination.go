@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,7 +24,7 @@ func resourceNetboxCircuitTermination() *schema.Resource {
 >
 > Each circuit termination is attached to either a site or to a provider network. Site terminations may optionally be connected via a cable to a specific device interface or port within that site. Each termination must be assigned a port speed, and can optionally be assigned an upstream speed if it differs from the downstream speed (a common scenario with e.g. DOCSIS cable modems). Fields are also available to track cross-connect and patch panel details.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"circuit_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -50,7 +49,7 @@ func resourceNetboxCircuitTermination() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,7 +57,7 @@ func resourceNetboxCircuitTermination() *schema.Resource {
 }
 
 func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableCircuitTermination{}
 
@@ -89,7 +88,7 @@ func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := circuits.NewCircuitsCircuitTerminationsCreateParams().WithData(&data)
@@ -105,7 +104,7 @@ func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitTerminationsReadParams().WithID(id)
 
@@ -151,18 +150,19 @@ func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{})
 		d.Set("upstream_speed", nil)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(term.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, term.Tags))
 
-	cf := getCustomFields(term.CustomFields)
+	cf := getCustomFields(api, term.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableCircuitTermination{}
@@ -194,7 +194,7 @@ func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	params := circuits.NewCircuitsCircuitTerminationsPartialUpdateParams().WithID(id).WithData(&data)
@@ -208,7 +208,7 @@ func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{
 }
 
 func resourceNetboxCircuitTerminationDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := circuits.NewCircuitsCircuitTerminationsDeleteParams().WithID(id)
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +21,7 @@ func resourceNetboxCluster() *schema.Resource {
 >
 > Physical devices may be associated with clusters as hosts. This allows users to track on which host(s) a particular virtual machine may reside. However, NetBox does not support pinning a specific VM within a cluster to a particular host device.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,10 +30,12 @@ func resourceNetboxCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"cluster_type": relatedObjectSchema,
 			"cluster_group_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"cluster_group": relatedObjectSchema,
 			"comments": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -47,12 +48,15 @@ func resourceNetboxCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"site": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			"tenant":        relatedObjectSchema,
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -60,7 +64,7 @@ func resourceNetboxCluster() *schema.Resource {
 }
 
 func resourceNetboxClusterCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableCluster{}
 
@@ -91,6 +95,10 @@ func resourceNetboxClusterCreate(d *schema.ResourceData, m interface{}) error {
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := virtualization.NewVirtualizationClustersCreateParams().WithData(&data)
 
 	res, err := api.Virtualization.VirtualizationClustersCreate(params, nil)
@@ -105,7 +113,7 @@ func resourceNetboxClusterCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClustersReadParams().WithID(id)
 
@@ -124,12 +132,14 @@ func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("cluster_type_id", res.GetPayload().Type.ID)
+	d.Set("cluster_type", getRelatedObject(res.GetPayload().Type))
 
 	if res.GetPayload().Group != nil {
 		d.Set("cluster_group_id", res.GetPayload().Group.ID)
 	} else {
 		d.Set("cluster_group_id", nil)
 	}
+	d.Set("cluster_group", getRelatedObject(res.GetPayload().Group))
 
 	d.Set("comments", res.GetPayload().Comments)
 	d.Set("description", res.GetPayload().Description)
@@ -139,19 +149,28 @@ func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(res.GetPayload().Site))
 
 	if res.GetPayload().Tenant != nil {
 		d.Set("tenant_id", res.GetPayload().Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(res.GetPayload().Tenant))
+
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxClusterUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableCluster{}
@@ -183,9 +202,13 @@ func resourceNetboxClusterUpdate(d *schema.ResourceData, m interface{}) error {
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := virtualization.NewVirtualizationClustersPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Virtualization.VirtualizationClustersPartialUpdate(params, nil)
+	_, err := api.Virtualization.VirtualizationClustersPartialUpdate(params, nil, withClearedStrings(d, "comments", "description"))
 	if err != nil {
 		return err
 	}
@@ -194,7 +217,7 @@ func resourceNetboxClusterUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxClusterDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClustersDeleteParams().WithID(id)
@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxClusterGroup() *schema.Resource {
@@ -21,7 +19,7 @@ func resourceNetboxClusterGroup() *schema.Resource {
 
 > Cluster groups may be created for the purpose of organizing clusters. The arrangement of clusters into groups is optional.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,13 +28,15 @@ func resourceNetboxClusterGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -44,7 +44,7 @@ func resourceNetboxClusterGroup() *schema.Resource {
 }
 
 func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.ClusterGroup{}
 
@@ -65,7 +65,12 @@ func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) err
 		data.Description = description.(string)
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := virtualization.NewVirtualizationClusterGroupsCreateParams().WithData(&data)
 
@@ -80,7 +85,7 @@ func resourceNetboxClusterGroupCreate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterGroupsReadParams().WithID(id)
 
@@ -100,11 +105,19 @@ func resourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("description", res.GetPayload().Description)
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.ClusterGroup{}
@@ -131,11 +144,16 @@ func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := virtualization.NewVirtualizationClusterGroupsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Virtualization.VirtualizationClusterGroupsPartialUpdate(params, nil)
+	_, err := api.Virtualization.VirtualizationClusterGroupsPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -144,7 +162,7 @@ func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxClusterGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationClusterGroupsDeleteParams().WithID(id)
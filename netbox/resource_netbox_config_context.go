@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +21,7 @@ func resourceNetboxConfigContext() *schema.Resource {
 
 > Context data is made available to devices and/or virtual machines based on their relationships to other objects in NetBox. For example, context data can be associated only with devices assigned to a particular site, or only to virtual machines in a certain cluster.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -145,7 +144,7 @@ func resourceNetboxConfigContext() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -153,7 +152,7 @@ func resourceNetboxConfigContext() *schema.Resource {
 }
 
 func resourceNetboxConfigContextCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableConfigContext{}
 	data.Name = strToPtr(d.Get("name").(string))
 
@@ -195,7 +194,7 @@ func resourceNetboxConfigContextCreate(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasConfigContextsReadParams().WithID(id)
 
@@ -314,11 +313,12 @@ func resourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) erro
 	}
 	d.Set("tenants", tenantsSlice)
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -353,7 +353,7 @@ func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) er
 
 	params := extras.NewExtrasConfigContextsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasConfigContextsPartialUpdate(params, nil)
+	_, err := api.Extras.ExtrasConfigContextsPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -362,7 +362,7 @@ func resourceNetboxConfigContextUpdate(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNetboxConfigContextDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasConfigContextsDeleteParams().WithID(id)
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,7 +24,7 @@ func resourceNetboxConfigTemplate() *schema.Resource {
 > Configuration templates can be used to render device configurations from context data. Templates are written in the Jinja2 language and can be associated with devices roles, platforms, and/or individual devices.
 
 > Context data is made available to devices and/or virtual machines based on their relationships to other objects in NetBox. For example, context data can be associated only with devices assigned to a particular site, or only to virtual machines in a certain cluster.`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -46,7 +45,7 @@ func resourceNetboxConfigTemplate() *schema.Resource {
 				ValidateFunc: validation.StringIsJSON,
 			},
 			tagsKey: tagsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +53,7 @@ func resourceNetboxConfigTemplate() *schema.Resource {
 }
 
 func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -97,7 +96,7 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	var diags diag.Diagnostics
@@ -133,12 +132,14 @@ func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("environment_params", "{}")
 	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, tmpl.Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return diags
 }
 
 func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -171,7 +172,7 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	params := extras.NewExtrasConfigTemplatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Extras.ExtrasConfigTemplatesUpdate(params, nil)
+	_, err := api.Extras.ExtrasConfigTemplatesUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -180,7 +181,7 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetboxConfigTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasConfigTemplatesDeleteParams().WithID(id)
@@ -0,0 +1,163 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxConsolePortTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxConsolePortTemplateCreate,
+		ReadContext:   resourceNetboxConsolePortTemplateRead,
+		UpdateContext: resourceNetboxConsolePortTemplateUpdate,
+		DeleteContext: resourceNetboxConsolePortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleporttemplate/):
+
+> A template for a console port that will be created on all instantiations of the parent device type. See the console port documentation for more detail.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDeviceConsolePortTypeOptions),
+				Description:      buildValidValueDescription(resourceNetboxDeviceConsolePortTypeOptions),
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxConsolePortTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritableConsolePortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Type:        d.Get("type").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimConsolePortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxConsolePortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxConsolePortTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimConsolePortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimConsolePortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsolePortTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxConsolePortTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableConsolePortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Type:        d.Get("type").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimConsolePortTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxConsolePortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxConsolePortTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimConsolePortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsolePortTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
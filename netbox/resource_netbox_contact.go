@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/go-openapi/strfmt"
@@ -23,7 +22,7 @@ func resourceNetboxContact() *schema.Resource {
 >
 > Contacts are reused for assignments, so each unique contact must be created only once and can be assigned to any number of NetBox objects, and there is no limit to the number of assigned contacts an object may have. Most core objects in NetBox can have contacts assigned to them.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,7 +40,8 @@ func resourceNetboxContact() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -49,7 +49,7 @@ func resourceNetboxContact() *schema.Resource {
 }
 
 func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	phone := d.Get("phone").(string)
@@ -69,6 +69,10 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 		data.Group = &groupID
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := tenancy.NewTenancyContactsCreateParams().WithData(data)
 
 	res, err := api.Tenancy.TenancyContactsCreate(params, nil)
@@ -82,7 +86,7 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactsReadParams().WithID(id)
 
@@ -105,12 +109,19 @@ func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 	if res.GetPayload().Group != nil {
 		d.Set("group_id", res.GetPayload().Group.ID)
 	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableContact{}
@@ -130,6 +141,10 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Group = &groupID
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := tenancy.NewTenancyContactsPartialUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactsPartialUpdate(params, nil)
@@ -141,7 +156,7 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxContactDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactsDeleteParams().WithID(id)
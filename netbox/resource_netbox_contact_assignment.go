@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +22,7 @@ func resourceNetboxContactAssignment() *schema.Resource {
 
 > Much like tenancy, contact assignment enables you to track ownership of resources modeled in NetBox.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"content_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -46,7 +45,7 @@ func resourceNetboxContactAssignment() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(resourceNetboxContactAssignmentPriorityOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxContactAssignmentPriorityOptions),
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +53,7 @@ func resourceNetboxContactAssignment() *schema.Resource {
 }
 
 func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	contentType := d.Get("content_type").(string)
 	objectID := int64(d.Get("object_id").(int))
@@ -83,7 +82,7 @@ func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactAssignmentsReadParams().WithID(id)
 
@@ -115,11 +114,12 @@ func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{})
 		d.Set("priority", res.GetPayload().Priority.Value)
 	}
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableContactAssignment{}
@@ -153,7 +153,7 @@ func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxContactAssignmentDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactAssignmentsDeleteParams().WithID(id)
@@ -5,7 +5,6 @@ import (
 	"log"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -80,7 +79,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := tenancy.NewTenancyContactAssignmentsListParams()
 			res, err := api.Tenancy.TenancyContactAssignmentsList(params, nil)
 			if err != nil {
@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxContactGroup() *schema.Resource {
@@ -21,7 +19,7 @@ func resourceNetboxContactGroup() *schema.Resource {
 
 > Contacts can be grouped arbitrarily into a recursive hierarchy, and a contact can be assigned to a group at any level within the hierarchy.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,7 +28,7 @@ func resourceNetboxContactGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"parent_id": {
 				Type:     schema.TypeInt,
@@ -40,7 +38,9 @@ func resourceNetboxContactGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -48,7 +48,7 @@ func resourceNetboxContactGroup() *schema.Resource {
 }
 
 func resourceNetboxContactGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	parentID := int64(d.Get("parent_id").(int))
@@ -67,12 +67,17 @@ func resourceNetboxContactGroupCreate(d *schema.ResourceData, m interface{}) err
 	data.Name = &name
 	data.Slug = &slug
 	data.Description = description
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := tenancy.NewTenancyContactGroupsCreateParams().WithData(data)
 
 	res, err := api.Tenancy.TenancyContactGroupsCreate(params, nil)
@@ -86,7 +91,7 @@ func resourceNetboxContactGroupCreate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	params := tenancy.NewTenancyContactGroupsReadParams().WithID(id)
@@ -110,11 +115,19 @@ func resourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error
 	if res.GetPayload().Parent != nil {
 		d.Set("parent", res.GetPayload().Parent.ID)
 	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxContactGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableContactGroup{}
@@ -135,14 +148,19 @@ func resourceNetboxContactGroupUpdate(d *schema.ResourceData, m interface{}) err
 	data.Slug = &slug
 	data.Name = &name
 	data.Description = description
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := tenancy.NewTenancyContactGroupsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Tenancy.TenancyContactGroupsPartialUpdate(params, nil)
+	_, err := api.Tenancy.TenancyContactGroupsPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -151,7 +169,7 @@ func resourceNetboxContactGroupUpdate(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceNetboxContactGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactGroupsDeleteParams().WithID(id)
@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxContactRole() *schema.Resource {
@@ -21,7 +19,7 @@ func resourceNetboxContactRole() *schema.Resource {
 
 > A contact role defines the relationship of a contact to an assigned object. For example, you might define roles for administrative, operational, and emergency contacts`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,9 +28,11 @@ func resourceNetboxContactRole() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -40,7 +40,7 @@ func resourceNetboxContactRole() *schema.Resource {
 }
 
 func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -55,7 +55,12 @@ func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := tenancy.NewTenancyContactRolesCreateParams().WithData(data)
 
@@ -70,7 +75,7 @@ func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactRolesReadParams().WithID(id)
 
@@ -92,11 +97,19 @@ func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error
 	d.Set("name", contactrole.Name)
 	d.Set("slug", contactrole.Slug)
 
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.ContactRole{}
@@ -111,7 +124,12 @@ func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := tenancy.NewTenancyContactRolesPartialUpdateParams().WithID(id).WithData(&data)
 
@@ -124,7 +142,7 @@ func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxContactRoleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := tenancy.NewTenancyContactRolesDeleteParams().WithID(id)
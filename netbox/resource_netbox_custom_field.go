@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +22,7 @@ func resourceCustomField() *schema.Resource {
 >
 > However, some users might want to store additional object attributes that are somewhat esoteric in nature, and that would not make sense to include in the core NetBox database schema. For instance, suppose your organization needs to associate each device with a ticket number correlating it with an internal support system record. This is certainly a legitimate use for NetBox, but it's not a common enough need to warrant including a field for every NetBox installation. Instead, you can create a custom field to hold this data.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,7 +32,9 @@ func resourceCustomField() *schema.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					models.CustomFieldTypeValueText,
+					models.CustomFieldTypeValueLongtext,
 					models.CustomFieldTypeValueInteger,
+					models.CustomFieldTypeValueDecimal,
 					models.CustomFieldTypeValueBoolean,
 					models.CustomFieldTypeValueDate,
 					models.CustomFieldTypeValueURL,
@@ -93,7 +94,7 @@ func resourceCustomField() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -101,7 +102,7 @@ func resourceCustomField() *schema.Resource {
 }
 
 func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -142,7 +143,7 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	params := extras.NewExtrasCustomFieldsUpdateParams().WithID(id).WithData(data)
-	res, err := api.Extras.ExtrasCustomFieldsUpdate(params, nil)
+	res, err := api.Extras.ExtrasCustomFieldsUpdate(params, nil, withClearedStrings(d, "description", "label"))
 	if err != nil {
 		return err
 	}
@@ -153,7 +154,7 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := &models.WritableCustomField{
 		Name:            strToPtr(d.Get("name").(string)),
@@ -205,7 +206,7 @@ func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxCustomFieldRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasCustomFieldsReadParams().WithID(id)
 	res, err := api.Extras.ExtrasCustomFieldsRead(params, nil)
@@ -247,11 +248,12 @@ func resourceNetboxCustomFieldRead(d *schema.ResourceData, m interface{}) error
 	d.Set("validation_minimum", customField.ValidationMinimum)
 	d.Set("validation_regex", customField.ValidationRegex)
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxCustomFieldDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasCustomFieldsDeleteParams().WithID(id)
 	_, err := api.Extras.ExtrasCustomFieldsDelete(params, nil)
@@ -3,9 +3,11 @@ package netbox
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
+	"time"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +17,26 @@ import (
 
 var resourceNetboxDeviceStatusOptions = []string{"offline", "active", "planned", "staged", "failed", "inventory"}
 var resourceNetboxDeviceRackFaceOptions = []string{"front", "rear"}
+var resourceNetboxDeviceAirflowOptions = []string{"front-to-rear", "rear-to-front", "left-to-right", "right-to-left", "side-to-rear", "rear-to-side", "bottom-to-top", "top-to-bottom", "passive", "mixed"}
+
+// writableDevice adds the fields to the go-netbox model that it does not know.
+// Airflow shadows the field of the model, which is omitted when empty, so it
+// can be unset.
+type writableDevice struct {
+	models.WritableDeviceWithConfigContext
+	Airflow   string   `json:"airflow"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	OobIP     *int64   `json:"oob_ip"`
+}
+
+// device adds the fields to the go-netbox model that it does not know.
+type device struct {
+	models.DeviceWithConfigContext
+	Latitude  *float64                `json:"latitude"`
+	Longitude *float64                `json:"longitude"`
+	OobIP     *models.NestedIPAddress `json:"oob_ip"`
+}
 
 func resourceNetboxDevice() *schema.Resource {
 	return &schema.Resource{
@@ -27,7 +49,7 @@ func resourceNetboxDevice() *schema.Resource {
 
 > Every piece of hardware which is installed within a site or rack exists in NetBox as a device. Devices are measured in rack units (U) and can be half depth or full depth. A device may have a height of 0U: These devices do not consume vertical rack space and cannot be assigned to a particular rack unit. A common example of a 0U device is a vertically-mounted PDU.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,26 +58,32 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"device_type": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"cluster_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"cluster": relatedObjectSchema,
 			"platform_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"platform": relatedObjectSchema,
 			"location_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"location": relatedObjectSchema,
 			"role_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"role": relatedObjectSchema,
 			"serial": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,6 +92,7 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"site": relatedObjectSchema,
 			"config_template_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -89,6 +118,25 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"oob_ip_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The out-of-band IP address of the device. It must be assigned to an interface of the device.",
+			},
+			"airflow": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceAirflowOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceAirflowOptions),
+			},
+			"latitude": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"longitude": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
 			"status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -100,6 +148,7 @@ func resourceNetboxDevice() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"rack": relatedObjectSchema,
 			"rack_face": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,12 +166,18 @@ func resourceNetboxDevice() *schema.Resource {
 				RequiredWith: []string{"virtual_chassis_master", "virtual_chassis_id"},
 			},
 			"virtual_chassis_position": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The position of the device in the virtual chassis, e.g. its stack member number.",
 			},
 			"virtual_chassis_priority": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"virtual_chassis_id"},
+				ValidateFunc: validation.IntBetween(0, 255),
+				Description:  "The priority of the device in the election of the virtual chassis master.",
 			},
 			"virtual_chassis_master": {
 				Type:         schema.TypeBool,
@@ -135,15 +190,19 @@ func resourceNetboxDevice() *schema.Resource {
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
 func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -237,14 +296,25 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	params := dcim.NewDcimDevicesCreateParams().WithData(&data)
+	// Netbox creates the components of the device type along with the
+	// device, which can take longer than the request timeout
+	ctx, cancel := withOperationTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	params := dcim.NewDcimDevicesCreateParams().WithData(&data).WithContext(ctx).WithTimeout(d.Timeout(schema.TimeoutCreate))
 
-	res, err := api.Dcim.DcimDevicesCreate(params, nil)
+	res, err := api.Dcim.DcimDevicesCreate(params, nil, withRequestBody(&writableDevice{
+		WritableDeviceWithConfigContext: data,
+		Airflow:                         d.Get("airflow").(string),
+		Latitude:                        getOptionalFloat(d, "latitude"),
+		Longitude:                       getOptionalFloat(d, "longitude"),
+		OobIP:                           getOptionalInt(d, "oob_ip_id"),
+	}))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -267,34 +337,36 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-
-	params := dcim.NewDcimDevicesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimDevicesRead(params, nil)
+	res, err := apiRequest(api, http.MethodGet, "/dcim/devices/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimDevicesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
 
-	device := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var device device
+	if err := json.Unmarshal(payload, &device); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.Set("name", device.Name)
 
 	if device.DeviceType != nil {
 		d.Set("device_type_id", device.DeviceType.ID)
 	}
+	d.Set("device_type", getRelatedObject(device.DeviceType))
 
 	if device.PrimaryIp4 != nil {
 		d.Set("primary_ipv4", device.PrimaryIp4.ID)
@@ -308,41 +380,53 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("primary_ipv6", nil)
 	}
 
+	if device.OobIP != nil {
+		d.Set("oob_ip_id", device.OobIP.ID)
+	} else {
+		d.Set("oob_ip_id", nil)
+	}
+
 	if device.Tenant != nil {
 		d.Set("tenant_id", device.Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(device.Tenant))
 
 	if device.Platform != nil {
 		d.Set("platform_id", device.Platform.ID)
 	} else {
 		d.Set("platform_id", nil)
 	}
+	d.Set("platform", getRelatedObject(device.Platform))
 
 	if device.Location != nil {
 		d.Set("location_id", device.Location.ID)
 	} else {
 		d.Set("location_id", nil)
 	}
+	d.Set("location", getRelatedObject(device.Location))
 
 	if device.Cluster != nil {
 		d.Set("cluster_id", device.Cluster.ID)
 	} else {
 		d.Set("cluster_id", nil)
 	}
+	d.Set("cluster", getRelatedObject(device.Cluster))
 
 	if device.Role != nil {
 		d.Set("role_id", device.Role.ID)
 	} else {
 		d.Set("role_id", nil)
 	}
+	d.Set("role", getRelatedObject(device.Role))
 
 	if device.Site != nil {
 		d.Set("site_id", device.Site.ID)
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(device.Site))
 
 	if device.ConfigTemplate != nil {
 		d.Set("config_template_id", device.ConfigTemplate.ID)
@@ -350,7 +434,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("config_template_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, device.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -370,6 +454,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 	} else {
 		d.Set("rack_id", nil)
 	}
+	d.Set("rack", getRelatedObject(device.Rack))
 
 	if device.Face != nil {
 		d.Set("rack_face", device.Face.Value)
@@ -379,6 +464,15 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	d.Set("rack_position", device.Position)
 
+	if device.Airflow != nil && device.Airflow.Value != nil {
+		d.Set("airflow", device.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+
+	d.Set("latitude", device.Latitude)
+	d.Set("longitude", device.Longitude)
+
 	if device.VirtualChassis != nil {
 		d.Set("virtual_chassis_id", device.VirtualChassis.ID)
 		d.Set(
@@ -400,12 +494,14 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("local_context_data", nil)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(device.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, device.Tags))
+
+	setObjectMetadata(d, &device.DeviceWithConfigContext)
 	return diags
 }
 
 func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableDeviceWithConfigContext{}
@@ -483,7 +579,7 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
@@ -530,7 +626,13 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	params := dcim.NewDcimDevicesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimDevicesUpdate(params, nil)
+	_, err := api.Dcim.DcimDevicesUpdate(params, nil, withRequestBody(&writableDevice{
+		WritableDeviceWithConfigContext: data,
+		Airflow:                         d.Get("airflow").(string),
+		Latitude:                        getOptionalFloat(d, "latitude"),
+		Longitude:                       getOptionalFloat(d, "longitude"),
+		OobIP:                           getOptionalInt(d, "oob_ip_id"),
+	}), withClearedStrings(d, "comments", "description", "serial"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -556,7 +658,7 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -574,7 +676,10 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	params := dcim.NewDcimDevicesDeleteParams().WithID(id)
+	ctx, cancel := withOperationTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	params := dcim.NewDcimDevicesDeleteParams().WithID(id).WithContext(ctx).WithTimeout(d.Timeout(schema.TimeoutDelete))
 
 	_, err := api.Dcim.DcimDevicesDelete(params, nil)
 	if err != nil {
@@ -0,0 +1,181 @@
+package netbox
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceBay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceBayCreate,
+		Read:   resourceNetboxDeviceBayRead,
+		Update: resourceNetboxDeviceBayUpdate,
+		Delete: resourceNetboxDeviceBayDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebay/):
+
+> Device bays represent a space or slot within a parent device in which a child device may be installed. For example, a 2U parent chassis might house four individual blade servers. The chassis would appear in the rack elevation as a 2U device with four device bays, and each server within it would be defined as a 0U device installed in one of the device bays. Child devices do not appear within rack elevations or count as consuming rack units.`,
+
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"installed_device_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the child device installed in the bay. Its device type must have the subdevice role `child`.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := models.WritableDeviceBay{
+		Device:          int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:            strToPtr(d.Get("name").(string)),
+		Label:           d.Get("label").(string),
+		InstalledDevice: getOptionalInt(d, "installed_device_id"),
+		Description:     d.Get("description").(string),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	ct, ok := d.GetOk(customFieldsKey)
+	if ok {
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
+	}
+
+	params := dcim.NewDcimDeviceBaysCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimDeviceBaysCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBaysRead(params, nil)
+
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBaysReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	deviceBay := res.GetPayload()
+
+	if deviceBay.Device != nil {
+		d.Set("device_id", deviceBay.Device.ID)
+	} else {
+		d.Set("device_id", nil)
+	}
+
+	if deviceBay.InstalledDevice != nil {
+		d.Set("installed_device_id", deviceBay.InstalledDevice.ID)
+	} else {
+		d.Set("installed_device_id", nil)
+	}
+
+	d.Set("name", deviceBay.Name)
+	d.Set("label", deviceBay.Label)
+	d.Set("description", deviceBay.Description)
+
+	cf := getCustomFields(api, deviceBay.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, deviceBay.Tags))
+
+	setObjectMetadata(d, deviceBay)
+	return nil
+}
+
+func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// The installed device is omitted from the request when it is empty, so
+	// a removed child device has to be cleared explicitly.
+	if d.HasChange("installed_device_id") && getOptionalInt(d, "installed_device_id") == nil {
+		_, err := apiRequest(api, http.MethodPatch, "/dcim/device-bays/"+d.Id()+"/", nil, map[string]interface{}{"installed_device": nil})
+		if err != nil {
+			return err
+		}
+	}
+
+	data := models.WritableDeviceBay{
+		Device:          int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:            strToPtr(d.Get("name").(string)),
+		Label:           d.Get("label").(string),
+		InstalledDevice: getOptionalInt(d, "installed_device_id"),
+		Description:     d.Get("description").(string),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	ct, ok := d.GetOk(customFieldsKey)
+	if ok {
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
+	}
+
+	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimDeviceBaysPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBaysDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBaysDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
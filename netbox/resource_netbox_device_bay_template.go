@@ -0,0 +1,138 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDeviceBayTemplateCreate,
+		ReadContext:   resourceNetboxDeviceBayTemplateRead,
+		UpdateContext: resourceNetboxDeviceBayTemplateUpdate,
+		DeleteContext: resourceNetboxDeviceBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebaytemplate/):
+
+> A template for a device bay that will be created on all instantiations of the parent device type. Device bays hold child devices, such as blade servers.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_type_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The device type must have the subdevice role `parent`.",
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritableDeviceBayTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	params := dcim.NewDcimDeviceBayTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceBayTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimDeviceBayTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBayTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBayTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxDeviceBayTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableDeviceBayTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	params := dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimDeviceBayTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxDeviceBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxDeviceBayTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBayTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBayTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBayTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -3,12 +3,25 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// resourceNetboxDeviceConsolePortTypeOptions are the console port types of
+// Netbox 4.0, which are shared by console server ports and the templates.
+var resourceNetboxDeviceConsolePortTypeOptions = []string{
+	"de-9", "db-25", "rj-11", "rj-12", "rj-45", "mini-din-8", "usb-a", "usb-b", "usb-c",
+	"usb-mini-a", "usb-mini-b", "usb-micro-a", "usb-micro-b", "usb-micro-ab", "other",
+}
+
+// resourceNetboxDeviceConsolePortSpeedOptions are the console port speeds of
+// Netbox 4.0.
+var resourceNetboxDeviceConsolePortSpeedOptions = []int{
+	1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200,
+}
+
 func resourceNetboxDeviceConsolePort() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxDeviceConsolePortCreate,
@@ -20,7 +33,7 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 
 > A console port provides connectivity to the physical console of a device. These are typically used for temporary access by someone who is physically near the device, or for remote out-of-band access provided via a networked console server.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -38,14 +51,16 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 				Optional: true,
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "One of [de-9, db-25, rj-11, rj-12, rj-45, mini-din-8, usb-a, usb-b, usb-c, usb-mini-a, usb-mini-b, usb-micro-a, usb-micro-b, usb-micro-ab, other]",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "One of [de-9, db-25, rj-11, rj-12, rj-45, mini-din-8, usb-a, usb-b, usb-c, usb-mini-a, usb-mini-b, usb-micro-a, usb-micro-b, usb-micro-ab, other]",
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDeviceConsolePortTypeOptions),
 			},
 			"speed": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "One of [1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200]",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "One of [1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200]",
+				ValidateFunc: validation.IntInSlice(resourceNetboxDeviceConsolePortSpeedOptions),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -58,7 +73,7 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,7 +81,7 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 }
 
 func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableConsolePort{
 		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
@@ -83,7 +98,7 @@ func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimConsolePortsCreateParams().WithData(&data)
@@ -99,7 +114,7 @@ func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimConsolePortsReadParams().WithID(id)
 
@@ -147,17 +162,18 @@ func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{})
 	d.Set("description", consolePort.Description)
 	d.Set("mark_connected", consolePort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -176,12 +192,12 @@ func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimConsolePortsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimConsolePortsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimConsolePortsPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
 	if err != nil {
 		return err
 	}
@@ -190,7 +206,7 @@ func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}
 }
 
 func resourceNetboxDeviceConsolePortDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimConsolePortsDeleteParams().WithID(id)
@@ -3,10 +3,10 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
@@ -20,7 +20,7 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 
 > A console server is a device which provides remote access to the local consoles of connected devices. They are typically used to provide remote out-of-band access to network devices, and generally connect to console ports.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -38,14 +38,16 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 				Optional: true,
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "One of [de-9, db-25, rj-11, rj-12, rj-45, mini-din-8, usb-a, usb-b, usb-c, usb-mini-a, usb-mini-b, usb-micro-a, usb-micro-b, usb-micro-ab, other]",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "One of [de-9, db-25, rj-11, rj-12, rj-45, mini-din-8, usb-a, usb-b, usb-c, usb-mini-a, usb-mini-b, usb-micro-a, usb-micro-b, usb-micro-ab, other]",
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDeviceConsolePortTypeOptions),
 			},
 			"speed": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "One of [1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200]",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "One of [1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200]",
+				ValidateFunc: validation.IntInSlice(resourceNetboxDeviceConsolePortSpeedOptions),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -58,7 +60,7 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,7 +68,7 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 }
 
 func resourceNetboxDeviceConsoleServerPortCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableConsoleServerPort{
 		Device:        int64ToPtr(int64(d.Get("device_id").(int))),
@@ -83,7 +85,7 @@ func resourceNetboxDeviceConsoleServerPortCreate(d *schema.ResourceData, m inter
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimConsoleServerPortsCreateParams().WithData(&data)
@@ -99,7 +101,7 @@ func resourceNetboxDeviceConsoleServerPortCreate(d *schema.ResourceData, m inter
 }
 
 func resourceNetboxDeviceConsoleServerPortRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimConsoleServerPortsReadParams().WithID(id)
 
@@ -147,17 +149,18 @@ func resourceNetboxDeviceConsoleServerPortRead(d *schema.ResourceData, m interfa
 	d.Set("description", consoleServerPort.Description)
 	d.Set("mark_connected", consoleServerPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxDeviceConsoleServerPortUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -176,12 +179,12 @@ func resourceNetboxDeviceConsoleServerPortUpdate(d *schema.ResourceData, m inter
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimConsoleServerPortsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimConsoleServerPortsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimConsoleServerPortsPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
 	if err != nil {
 		return err
 	}
@@ -190,7 +193,7 @@ func resourceNetboxDeviceConsoleServerPortUpdate(d *schema.ResourceData, m inter
 }
 
 func resourceNetboxDeviceConsoleServerPortDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimConsoleServerPortsDeleteParams().WithID(id)
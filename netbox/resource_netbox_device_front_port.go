@@ -3,12 +3,21 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// resourceNetboxDevicePortTypeOptions are the front and rear port types of
+// Netbox 4.0.
+var resourceNetboxDevicePortTypeOptions = []string{
+	"8p8c", "8p6c", "8p4c", "8p2c", "6p6c", "6p4c", "6p2c", "4p4c", "4p2c", "gg45", "tera-4p",
+	"tera-2p", "tera-1p", "110-punch", "bnc", "f", "n", "mrj21", "fc", "lc", "lc-pc",
+	"lc-upc", "lc-apc", "lsh", "lsh-pc", "lsh-upc", "lsh-apc", "mpo", "mtrj", "sc", "sc-pc",
+	"sc-upc", "sc-apc", "st", "cs", "sn", "sma-905", "sma-906", "urm-p2", "urm-p4", "urm-p8",
+	"splice", "other",
+}
+
 func resourceNetboxDeviceFrontPort() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxDeviceFrontPortCreate,
@@ -20,7 +29,7 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 
 > Front ports are pass-through ports which represent physical cable connections that comprise part of a longer path. For example, the ports on the front face of a UTP patch panel would be modeled in NetBox as front ports. Each port is assigned a physical type, and must be mapped to a specific rear port on the same device. A single rear port may be mapped to multiple front ports, using numeric positions to annotate the specific alignment of each.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -30,9 +39,10 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 				Required: true,
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "One of [8p8c, 8p6c, 8p4c, 8p2c, 6p6c, 6p4c, 6p2c, 4p4c, 4p2c, gg45, tera-4p, tera-2p, tera-1p, 110-punch, bnc, f, n, mrj21, fc, lc, lc-pc, lc-upc, lc-apc, lsh, lsh-pc, lsh-upc, lsh-apc, mpo, mtrj, sc, sc-pc, sc-upc, sc-apc, st, cs, sn, sma-905, sma-906, urm-p2, urm-p4, urm-p8, splice, other]",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "One of [8p8c, 8p6c, 8p4c, 8p2c, 6p6c, 6p4c, 6p2c, 4p4c, 4p2c, gg45, tera-4p, tera-2p, tera-1p, 110-punch, bnc, f, n, mrj21, fc, lc, lc-pc, lc-upc, lc-apc, lsh, lsh-pc, lsh-upc, lsh-apc, mpo, mtrj, sc, sc-pc, sc-upc, sc-apc, st, cs, sn, sma-905, sma-906, urm-p2, urm-p4, urm-p8, splice, other]",
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDevicePortTypeOptions),
 			},
 			"rear_port_id": {
 				Type:     schema.TypeInt,
@@ -51,8 +61,9 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 				Optional: true,
 			},
 			"color_hex": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateColorHex,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -65,7 +76,7 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -73,7 +84,7 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 }
 
 func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableFrontPort{
 		Device:           int64ToPtr(int64(d.Get("device_id").(int))),
@@ -92,7 +103,7 @@ func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{})
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimFrontPortsCreateParams().WithData(&data)
@@ -108,7 +119,7 @@ func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimFrontPortsReadParams().WithID(id)
 
@@ -159,17 +170,18 @@ func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) er
 	d.Set("description", frontPort.Description)
 	d.Set("mark_connected", frontPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -190,12 +202,12 @@ func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{})
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimFrontPortsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimFrontPortsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimFrontPortsPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
 	if err != nil {
 		return err
 	}
@@ -204,7 +216,7 @@ func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxDeviceFrontPortDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimFrontPortsDeleteParams().WithID(id)
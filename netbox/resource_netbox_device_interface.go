@@ -2,10 +2,14 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,6 +18,31 @@ import (
 )
 
 var resourceNetboxDeviceInterfaceModeOptions = []string{"access", "tagged", "tagged-all"}
+var resourceNetboxDeviceInterfaceDuplexOptions = []string{"half", "full", "auto"}
+
+// writableDeviceInterface adds the fields to the go-netbox model that it does
+// not know or omits when they are empty, so they can be unset.
+type writableDeviceInterface struct {
+	models.WritableInterface
+	PrimaryMacAddress *int64  `json:"primary_mac_address,omitempty"`
+	Mode              string  `json:"mode"`
+	UntaggedVlan      *int64  `json:"untagged_vlan"`
+	Lag               *int64  `json:"lag"`
+	Parent            *int64  `json:"parent"`
+	Bridge            *int64  `json:"bridge"`
+	PoeMode           *string `json:"poe_mode"`
+	PoeType           *string `json:"poe_type"`
+	Duplex            *string `json:"duplex"`
+	Wwn               *string `json:"wwn"`
+	TxPower           *int64  `json:"tx_power"`
+}
+
+// deviceInterface adds the fields to the go-netbox model that it does not
+// know.
+type deviceInterface struct {
+	models.Interface
+	PrimaryMacAddress *nestedMACAddress `json:"primary_mac_address"`
+}
 
 func resourceNetboxDeviceInterface() *schema.Resource {
 	return &schema.Resource{
@@ -21,11 +50,12 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 		ReadContext:   resourceNetboxDeviceInterfaceRead,
 		UpdateContext: resourceNetboxDeviceInterfaceUpdate,
 		DeleteContext: resourceNetboxDeviceInterfaceDelete,
+		CustomizeDiff: validateInterfaceVLANs,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device/#interface):
 
 > Interfaces in NetBox represent network interfaces used to exchange data with connected devices. On modern networks, these are most commonly Ethernet, but other types are supported as well. IP addresses and VLANs can be assigned to interfaces.`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -42,6 +72,11 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"bridge_device_interface_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The netbox_device_interface id of the bridge interface this interface is a member of.",
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -52,14 +87,12 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Optional:    true,
 				Description: "If this device is a member of a LAG group, you can reference the LAG interface here.",
 			},
-			"mac_address": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsMACAddress,
-				// Netbox converts MAC addresses always to uppercase
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return strings.EqualFold(old, new)
-				},
+			"mac_address": interfaceMACAddressSchema,
+			"primary_mac_address": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the primary MAC address of the interface, which must be assigned to the interface. See also `primary` of `netbox_mac_address`. Requires Netbox 4.2 or later.",
 			},
 			"mgmtonly": {
 				Type:     schema.TypeBool,
@@ -82,8 +115,40 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Description: "The netbox_device_interface id of the parent interface. Useful if this interface is a logical interface.",
 			},
 			"speed": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The speed of the interface in Kbps.",
+			},
+			"duplex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceDuplexOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceInterfaceDuplexOptions),
+			},
+			"poe_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInterfaceTemplatePoeModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxInterfaceTemplatePoeModeOptions),
+			},
+			"poe_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInterfaceTemplatePoeTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxInterfaceTemplatePoeTypeOptions),
+			},
+			"wwn": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^([0-9A-Fa-f]{2}:){7}[0-9A-Fa-f]{2}$`), "must be a WWN like 50:01:43:80:12:34:56:78"),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool { return strings.EqualFold(old, new) },
+				Description:      "The World Wide Name of the interface, e.g. of a Fibre Channel interface.",
+			},
+			"tx_power": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-40, 127),
+				Description:  "The transmit power of the interface in dBm.",
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -96,20 +161,66 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The IDs of the tagged VLANs. Requires `mode` to be `tagged` or `tagged-all`.",
 			},
 			"untagged_vlan": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the untagged VLAN. Requires `mode` to be set.",
 			},
-		},
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
+// validateInterfaceVLANs rejects VLANs that do not fit the 802.1Q mode of the
+// interface, which Netbox would refuse or silently drop.
+func validateInterfaceVLANs(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("mode") {
+		return nil
+	}
+	mode := d.Get("mode").(string)
+	if mode == "" && (!d.NewValueKnown("untagged_vlan") || d.Get("untagged_vlan").(int) != 0) {
+		return fmt.Errorf("untagged_vlan requires mode to be set")
+	}
+	if (mode == "" || mode == "access") && (!d.NewValueKnown("tagged_vlans") || d.Get("tagged_vlans").(*schema.Set).Len() > 0) {
+		return fmt.Errorf("tagged_vlans requires mode to be tagged or tagged-all, got %q", mode)
+	}
+	return nil
+}
+
+// getDeviceInterfaceBody returns the request body of the interface with the
+// fields of the go-netbox model given by data.
+func getDeviceInterfaceBody(d *schema.ResourceData, data models.WritableInterface) *writableDeviceInterface {
+	body := &writableDeviceInterface{
+		WritableInterface: data,
+		Mode:              d.Get("mode").(string),
+		UntaggedVlan:      getOptionalInt(d, "untagged_vlan"),
+		Lag:               getOptionalInt(d, "lag_device_interface_id"),
+		Parent:            getOptionalInt(d, "parent_device_interface_id"),
+		Bridge:            getOptionalInt(d, "bridge_device_interface_id"),
+		TxPower:           getOptionalInt(d, "tx_power"),
+	}
+	if value, ok := d.GetOk("poe_mode"); ok {
+		body.PoeMode = strToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("poe_type"); ok {
+		body.PoeType = strToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("duplex"); ok {
+		body.Duplex = strToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("wwn"); ok {
+		body.Wwn = strToPtr(value.(string))
+	}
+	return body
+}
+
 func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -119,7 +230,6 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 	interfaceType := d.Get("type").(string)
 	enabled := d.Get("enabled").(bool)
 	mgmtonly := d.Get("mgmtonly").(bool)
-	mode := d.Get("mode").(string)
 	tags, diagnostics := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
@@ -134,7 +244,6 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		Type:         &interfaceType,
 		Enabled:      enabled,
 		MgmtOnly:     mgmtonly,
-		Mode:         mode,
 		Tags:         tags,
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
@@ -142,58 +251,57 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		Vdcs:         []int64{},
 	}
 	if macAddress := d.Get("mac_address").(string); macAddress != "" {
-		data.MacAddress = &macAddress
-	}
-	if lag, ok := d.Get("lag_device_interface_id").(int); ok && lag != 0 {
-		data.Lag = int64ToPtr(int64(lag))
+		data.MacAddress = strToPtr(normalizeMACAddress(macAddress))
 	}
 	if mtu, ok := d.Get("mtu").(int); ok && mtu != 0 {
 		data.Mtu = int64ToPtr(int64(mtu))
 	}
-	if parent, ok := d.Get("parent_device_interface_id").(int); ok && parent != 0 {
-		data.Parent = int64ToPtr(int64(parent))
-	}
 	if speed, ok := d.Get("speed").(int); ok && speed != 0 {
 		data.Speed = int64ToPtr(int64(speed))
 	}
-	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
-		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
-	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
+	body := getDeviceInterfaceBody(d, data)
+	body.PrimaryMacAddress = getOptionalInt(d, "primary_mac_address")
 
-	res, err := api.Dcim.DcimInterfacesCreate(params, nil)
+	res, err := apiRequest(api, http.MethodPost, "/dcim/interfaces/", nil, body)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
 
 	return diags
 }
 
 func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimInterfacesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimInterfacesRead(params, nil)
+	res, err := apiRequest(api, http.MethodGet, "/dcim/interfaces/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimInterfacesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
 
-	iface := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var iface deviceInterface
+	if err := json.Unmarshal(payload, &iface); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.Set("name", iface.Name)
 	d.Set("description", iface.Description)
@@ -202,42 +310,81 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("enabled", iface.Enabled)
 	d.Set("mgmtonly", iface.MgmtOnly)
 	d.Set("mac_address", iface.MacAddress)
+	if iface.PrimaryMacAddress != nil {
+		d.Set("primary_mac_address", iface.PrimaryMacAddress.ID)
+	} else {
+		d.Set("primary_mac_address", nil)
+	}
 	d.Set("mtu", iface.Mtu)
 	d.Set("speed", iface.Speed)
-	d.Set(tagsKey, getTagListFromNestedTagList(iface.Tags))
+	d.Set("tx_power", iface.TxPower)
+	d.Set("wwn", iface.Wwn)
+
+	if iface.Duplex != nil {
+		d.Set("duplex", iface.Duplex.Value)
+	} else {
+		d.Set("duplex", nil)
+	}
+	if iface.PoeMode != nil {
+		d.Set("poe_mode", iface.PoeMode.Value)
+	} else {
+		d.Set("poe_mode", nil)
+	}
+	if iface.PoeType != nil {
+		d.Set("poe_type", iface.PoeType.Value)
+	} else {
+		d.Set("poe_type", nil)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, iface.Tags))
 	d.Set("tagged_vlans", getIDsFromNestedVLANDevice(iface.TaggedVlans))
 	d.Set("device_id", iface.Device.ID)
 
 	if iface.Lag != nil {
 		d.Set("lag_device_interface_id", iface.Lag.ID)
+	} else {
+		d.Set("lag_device_interface_id", nil)
 	}
 	if iface.Mode != nil {
 		d.Set("mode", iface.Mode.Value)
+	} else {
+		d.Set("mode", nil)
 	}
 	if iface.Parent != nil {
 		d.Set("parent_device_interface_id", iface.Parent.ID)
+	} else {
+		d.Set("parent_device_interface_id", nil)
+	}
+	if iface.Bridge != nil {
+		d.Set("bridge_device_interface_id", iface.Bridge.ID)
+	} else {
+		d.Set("bridge_device_interface_id", nil)
 	}
 	if iface.UntaggedVlan != nil {
 		d.Set("untagged_vlan", iface.UntaggedVlan.ID)
+	} else {
+		d.Set("untagged_vlan", nil)
+	}
+
+	cf := getCustomFields(api, iface.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
 	}
 
+	setObjectMetadata(d, &iface.Interface)
 	return diags
 }
 
 func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	label := d.Get("label").(string)
 	interfaceType := d.Get("type").(string)
 	enabled := d.Get("enabled").(bool)
 	mgmtonly := d.Get("mgmtonly").(bool)
-	mode := d.Get("mode").(string)
 	tags, diagnostics := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
@@ -252,7 +399,6 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		Type:         &interfaceType,
 		Enabled:      enabled,
 		MgmtOnly:     mgmtonly,
-		Mode:         mode,
 		Tags:         tags,
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
@@ -261,32 +407,33 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	if d.HasChange("mac_address") {
-		macAddress := d.Get("mac_address").(string)
+		macAddress := normalizeMACAddress(d.Get("mac_address").(string))
 		data.MacAddress = &macAddress
 	}
-	if d.HasChange("lag_device_interface_id") {
-		lag := int64(d.Get("lag_device_interface_id").(int))
-		data.Lag = &lag
-	}
 	if d.HasChange("mtu") {
 		mtu := int64(d.Get("mtu").(int))
 		data.Mtu = &mtu
 	}
-	if d.HasChange("parent_device_interface_id") {
-		parent := int64(d.Get("parent_device_interface_id").(int))
-		data.Parent = &parent
-	}
 	if d.HasChange("speed") {
 		speed := int64(d.Get("speed").(int))
 		data.Speed = &speed
 	}
-	if d.HasChange("untagged_vlan") {
-		untaggedvlan := int64(d.Get("untagged_vlan").(int))
-		data.UntaggedVlan = &untaggedvlan
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
+	body := getDeviceInterfaceBody(d, data)
+	if d.HasChange("primary_mac_address") {
+		body.PrimaryMacAddress = getOptionalInt(d, "primary_mac_address")
+	}
+
+	request, err := clearEmptyStrings(d, body, "description", "label")
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	params := dcim.NewDcimInterfacesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Dcim.DcimInterfacesPartialUpdate(params, nil)
+	_, err = apiRequest(api, http.MethodPatch, "/dcim/interfaces/"+d.Id()+"/", nil, request)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -295,7 +442,7 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimInterfacesDeleteParams().WithID(id)
@@ -2,11 +2,11 @@ package netbox
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -114,6 +114,52 @@ resource "netbox_device_interface" "testparent_child1" {
   parent_device_interface_id = "${netbox_device_interface.testparent.id}"
   type = "virtual"
 }
+resource "netbox_device_interface" "testbridge" {
+  name = "%[1]s_bridge"
+  device_id = netbox_device.test.id
+  type = "bridge"
+}
+resource "netbox_device_interface" "testbridge_member1" {
+  name = "%[1]s_bridgemember1"
+  device_id = netbox_device.test.id
+  bridge_device_interface_id = netbox_device_interface.testbridge.id
+  type = "virtual"
+}
+`, testName)
+}
+
+func testAccNetboxDeviceInterfaceParentAndLAGUnset(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_device_interface" "testLAG_parent" {
+  name = "%[1]s_parentlag"
+  device_id = netbox_device.test.id
+  type = "lag"
+}
+resource "netbox_device_interface" "testLAG_member1" {
+  name = "%[1]s_lagmember1"
+  device_id = netbox_device.test.id
+  type = "25gbase-x-sfp28"
+}
+resource "netbox_device_interface" "testparent" {
+  name = "%[1]s_parent_parent"
+  device_id = netbox_device.test.id
+  type = "25gbase-x-sfp28"
+}
+resource "netbox_device_interface" "testparent_child1" {
+  name = "%[1]s_parent_child"
+  device_id = netbox_device.test.id
+  type = "virtual"
+}
+resource "netbox_device_interface" "testbridge" {
+  name = "%[1]s_bridge"
+  device_id = netbox_device.test.id
+  type = "bridge"
+}
+resource "netbox_device_interface" "testbridge_member1" {
+  name = "%[1]s_bridgemember1"
+  device_id = netbox_device.test.id
+  type = "virtual"
+}
 `, testName)
 }
 
@@ -194,6 +240,8 @@ func TestAccNetboxDeviceInterface_parentAndLAG(t *testing.T) {
 
 					resource.TestCheckResourceAttr("netbox_device_interface.testparent_child1", "type", "virtual"),
 					resource.TestCheckResourceAttrPair("netbox_device_interface.testparent_child1", "parent_device_interface_id", "netbox_device_interface.testparent", "id"),
+
+					resource.TestCheckResourceAttrPair("netbox_device_interface.testbridge_member1", "bridge_device_interface_id", "netbox_device_interface.testbridge", "id"),
 				),
 			},
 			{
@@ -221,6 +269,19 @@ func TestAccNetboxDeviceInterface_parentAndLAG(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "netbox_device_interface.testbridge_member1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: setUp + testAccNetboxDeviceInterfaceParentAndLAGUnset(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.testLAG_member1", "lag_device_interface_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.testparent_child1", "parent_device_interface_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.testbridge_member1", "bridge_device_interface_id", "0"),
+				),
+			},
 		},
 	})
 }
@@ -265,6 +326,97 @@ func TestAccNetboxDeviceInterface_opts(t *testing.T) {
 	})
 }
 
+func TestAccNetboxDeviceInterface_physical(t *testing.T) {
+	testSlug := "iface_physical"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxDeviceInterfaceFullDependencies(testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_device_interface" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+  speed = 1000000
+  duplex = "full"
+  poe_mode = "pse"
+  poe_type = "type2-ieee802.3at"
+  wwn = "50:01:43:80:12:34:56:78"
+  tx_power = 20
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "speed", "1000000"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "duplex", "full"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "poe_mode", "pse"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "poe_type", "type2-ieee802.3at"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "wwn", "50:01:43:80:12:34:56:78"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "tx_power", "20"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+resource "netbox_device_interface" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "duplex", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "poe_mode", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "poe_type", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "wwn", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "tx_power", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetboxDeviceInterfaceVlansUnset(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_device_interface" "test1" {
+  name = "%[1]s_1"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "test2" {
+  name = "%[1]s_2"
+  mode = "access"
+  untagged_vlan = netbox_vlan.test1.id
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "test3" {
+  name = "%[1]s_3"
+  mode = "tagged-all"
+  tagged_vlans = [netbox_vlan.test1.id, netbox_vlan.test2.id]
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}`, testName)
+}
+
+func testAccNetboxDeviceInterfaceVlansInvalidMode(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_device_interface" "invalid" {
+  name = "%[1]s_invalid"
+  mode = "access"
+  tagged_vlans = [netbox_vlan.test2.id]
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}`, testName)
+}
+
 func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 	testSlug := "iface_vlan"
 	testName := testAccGetTestName(testSlug)
@@ -301,13 +453,27 @@ func TestAccNetboxDeviceInterface_vlans(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: setUp + testAccNetboxDeviceInterfaceVlansUnset(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.test1", "mode", ""),
+					resource.TestCheckResourceAttr("netbox_device_interface.test1", "untagged_vlan", "0"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test2", "mode", "access"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test2", "untagged_vlan", "netbox_vlan.test1", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test2", "tagged_vlans.#", "0"),
+				),
+			},
+			{
+				Config:      setUp + testAccNetboxDeviceInterfaceVlansUnset(testName) + testAccNetboxDeviceInterfaceVlansInvalidMode(testName),
+				ExpectError: regexp.MustCompile("tagged_vlans requires mode to be tagged or tagged-all"),
+			},
 		},
 	})
 }
 
 func testAccCheckDeviceInterfaceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each interface
 	// is destroyed
@@ -347,7 +513,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimInterfacesListParams()
 			res, err := api.Dcim.DcimInterfacesList(params, nil)
 			if err != nil {
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +20,7 @@ func resourceNetboxDeviceModuleBay() *schema.Resource {
 
 > Module bays represent a space or slot within a device in which a field-replaceable module may be installed. A common example is that of a chassis-based switch such as the Cisco Nexus 9000 or Juniper EX9200. Modules in turn hold additional components that become available to the parent device.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -45,7 +44,7 @@ func resourceNetboxDeviceModuleBay() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -53,7 +52,7 @@ func resourceNetboxDeviceModuleBay() *schema.Resource {
 }
 
 func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableModuleBay{
 		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
@@ -67,7 +66,7 @@ func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{})
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimModuleBaysCreateParams().WithData(&data)
@@ -83,7 +82,7 @@ func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimModuleBaysReadParams().WithID(id)
 
@@ -112,17 +111,18 @@ func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) er
 	d.Set("position", moduleBay.Position)
 	d.Set("description", moduleBay.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -138,12 +138,12 @@ func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{})
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimModuleBaysPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimModuleBaysPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimModuleBaysPartialUpdate(params, nil, withClearedStrings(d, "description", "label", "position"))
 	if err != nil {
 		return err
 	}
@@ -152,7 +152,7 @@ func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxDeviceModuleBayDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimModuleBaysDeleteParams().WithID(id)
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,7 +16,7 @@ func resourceNetboxDevicePrimaryIP() *schema.Resource {
 		Update: resourceNetboxDevicePrimaryIPUpdate,
 		Delete: resourceNetboxDevicePrimaryIPDelete,
 
-		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource is used to define the primary IP for a given device. The primary IP is reflected in the device Netbox UI, which identifies the Primary IPv4 and IPv6 addresses.`,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource is used to define the primary IP for a given device. The primary IP is reflected in the device Netbox UI, which identifies the Primary IPv4 and IPv6 addresses. It is imported by the ID of the device, followed by a space and ` + "`6`" + ` to import the primary IPv6 address instead of the IPv4 address.`,
 
 		Schema: map[string]*schema.Schema{
 			"device_id": {
@@ -36,7 +35,7 @@ func resourceNetboxDevicePrimaryIP() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetboxPrimaryIPImport(importPaths["netbox_device_primary_ip"]),
 		},
 	}
 }
@@ -48,7 +47,7 @@ func resourceNetboxDevicePrimaryIPCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxDevicePrimaryIPRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimDevicesReadParams().WithID(id)
 
@@ -82,7 +81,7 @@ func resourceNetboxDevicePrimaryIPRead(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNetboxDevicePrimaryIPUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	deviceID := int64(d.Get("device_id").(int))
 	IPAddressID := int64(d.Get("ip_address_id").(int))
@@ -1,15 +1,36 @@
 package netbox
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// writableDeviceRole adds the parent and config template, which go-netbox
+// does not know, and always sends the VM role flag and description so that
+// they can be set to false and unset respectively.
+type writableDeviceRole struct {
+	models.DeviceRole
+	VMRole         bool   `json:"vm_role"`
+	Description    string `json:"description"`
+	Parent         *int64 `json:"parent"`
+	ConfigTemplate *int64 `json:"config_template"`
+}
+
+// deviceRole adds the parent, depth and config template, which go-netbox does
+// not know.
+type deviceRole struct {
+	models.DeviceRole
+	Parent         *models.NestedDeviceRole     `json:"parent"`
+	Depth          int64                        `json:"_depth"`
+	ConfigTemplate *models.NestedConfigTemplate `json:"config_template"`
+}
+
 func resourceNetboxDeviceRole() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxDeviceRoleCreate,
@@ -21,7 +42,7 @@ func resourceNetboxDeviceRole() *schema.Resource {
 
 > Devices can be organized by functional roles, which are fully customizable by the user. For example, you might create roles for core switches, distribution switches, and access switches within your network.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,23 +51,41 @@ func resourceNetboxDeviceRole() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"vm_role": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether virtual machines may be assigned to the role.",
 			},
 			"color_hex": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateColorHex,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The parent role of the role. Requires Netbox 4.3 or later.",
+			},
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of the role in the hierarchy of roles, `0` for roles without parent.",
+			},
+			"config_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The config template used to render the configuration of devices and virtual machines of the role that have no config template of their own.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +93,7 @@ func resourceNetboxDeviceRole() *schema.Resource {
 }
 
 func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	slugValue, slugOk := d.GetOk("slug")
@@ -73,18 +112,25 @@ func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, m interface{}) error
 
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
-	params := dcim.NewDcimDeviceRolesCreateParams().WithData(
-		&models.DeviceRole{
-			Name:        &name,
-			Slug:        &slug,
-			Color:       color,
-			Description: description,
-			VMRole:      vmRole,
-			Tags:        tags,
-		},
-	)
+	data := models.DeviceRole{
+		Name:         &name,
+		Slug:         &slug,
+		Color:        color,
+		Description:  description,
+		VMRole:       vmRole,
+		CustomFields: getCustomFieldsForAPI(api, d.Get(customFieldsKey)),
+		Tags:         tags,
+	}
+
+	params := dcim.NewDcimDeviceRolesCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimDeviceRolesCreate(params, nil)
+	res, err := api.Dcim.DcimDeviceRolesCreate(params, nil, withRequestBody(&writableDeviceRole{
+		DeviceRole:     data,
+		VMRole:         vmRole,
+		Description:    description,
+		Parent:         getOptionalInt(d, "parent_id"),
+		ConfigTemplate: getOptionalInt(d, "config_template_id"),
+	}))
 	if err != nil {
 		//return errors.New(getTextFromError(err))
 		return err
@@ -96,34 +142,57 @@ func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceRolesReadParams().WithID(id)
+	api := m.(*providerState)
 
-	res, err := api.Dcim.DcimDeviceRolesRead(params, nil)
+	res, err := apiRequest(api, http.MethodGet, "/dcim/device-roles/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimDeviceRolesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	d.Set("name", res.GetPayload().Name)
-	d.Set("slug", res.GetPayload().Slug)
-	d.Set("vm_role", res.GetPayload().VMRole)
-	d.Set("color_hex", res.GetPayload().Color)
-	d.Set("description", res.GetPayload().Description)
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var deviceRole deviceRole
+	if err := json.Unmarshal(payload, &deviceRole); err != nil {
+		return err
+	}
+
+	d.Set("name", deviceRole.Name)
+	d.Set("slug", deviceRole.Slug)
+	d.Set("vm_role", deviceRole.VMRole)
+	d.Set("color_hex", deviceRole.Color)
+	d.Set("description", deviceRole.Description)
+	d.Set("depth", deviceRole.Depth)
+	if deviceRole.Parent != nil {
+		d.Set("parent_id", deviceRole.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+	if deviceRole.ConfigTemplate != nil {
+		d.Set("config_template_id", deviceRole.ConfigTemplate.ID)
+	} else {
+		d.Set("config_template_id", nil)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, deviceRole.Tags))
+
+	cf := getCustomFields(api, deviceRole.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, &deviceRole.DeviceRole)
 	return nil
 }
 
 func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.DeviceRole{}
@@ -152,9 +221,19 @@ func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, m interface{}) error
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimDeviceRolesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimDeviceRolesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimDeviceRolesPartialUpdate(params, nil, withRequestBody(&writableDeviceRole{
+		DeviceRole:     data,
+		VMRole:         vmRole,
+		Description:    description,
+		Parent:         getOptionalInt(d, "parent_id"),
+		ConfigTemplate: getOptionalInt(d, "config_template_id"),
+	}))
 	if err != nil {
 		return err
 	}
@@ -163,7 +242,7 @@ func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxDeviceRoleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimDeviceRolesDeleteParams().WithID(id)
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -65,6 +64,92 @@ resource "netbox_device_role" "test" {
 	})
 }
 
+func TestAccNetboxDeviceRole_configTemplate(t *testing.T) {
+	testSlug := "dvcrl_config_template"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_device_role" "test" {
+  name               = "%[1]s"
+  color_hex          = "111111"
+  description        = "Some fancy device role"
+  vm_role            = false
+  config_template_id = netbox_config_template.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_role.test", "vm_role", "false"),
+					resource.TestCheckResourceAttrPair("netbox_device_role.test", "config_template_id", "netbox_config_template.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "111111"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_role.test", "vm_role", "true"),
+					resource.TestCheckResourceAttr("netbox_device_role.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_device_role.test", "config_template_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDeviceRole_parent(t *testing.T) {
+	testSlug := "dvcrl_parent"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_device_role" "parent" {
+  name      = "%[1]s_parent"
+  color_hex = "111111"
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "222222"
+  parent_id = netbox_device_role.parent.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_role.test", "parent_id", "netbox_device_role.parent", "id"),
+					resource.TestCheckResourceAttr("netbox_device_role.test", "depth", "1"),
+					resource.TestCheckResourceAttr("netbox_device_role.parent", "depth", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_device_role", &resource.Sweeper{
 		Name:         "netbox_device_role",
@@ -74,7 +159,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimDeviceRolesListParams()
 			res, err := api.Dcim.DcimDeviceRolesList(params, nil)
 			if err != nil {
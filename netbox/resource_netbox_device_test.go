@@ -7,7 +7,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -119,6 +118,9 @@ resource "netbox_device" "test" {
   rack_id = netbox_rack.test.id
   rack_face = "front"
   rack_position = 10
+  airflow = "front-to-rear"
+  latitude = 50.110924
+  longitude = 8.682127
   local_context_data = jsonencode({"context_string"="context_value"})
 }`, testName),
 				Check: resource.ComposeTestCheckFunc(
@@ -140,6 +142,9 @@ resource "netbox_device" "test" {
 					resource.TestCheckResourceAttr("netbox_device.test", "tags.0", testName+"a"),
 					resource.TestCheckResourceAttr("netbox_device.test", "rack_face", "front"),
 					resource.TestCheckResourceAttr("netbox_device.test", "rack_position", "10"),
+					resource.TestCheckResourceAttr("netbox_device.test", "airflow", "front-to-rear"),
+					resource.TestCheckResourceAttr("netbox_device.test", "latitude", "50.110924"),
+					resource.TestCheckResourceAttr("netbox_device.test", "longitude", "8.682127"),
 					resource.TestCheckResourceAttr("netbox_device.test", "local_context_data", "{\"context_string\":\"context_value\"}"),
 				),
 			},
@@ -183,6 +188,9 @@ resource "netbox_device" "test" {
 					resource.TestCheckResourceAttr("netbox_device.test", "tags.0", testName+"a"),
 					resource.TestCheckResourceAttr("netbox_device.test", "rack_face", ""),
 					resource.TestCheckResourceAttr("netbox_device.test", "rack_position", "0"),
+					resource.TestCheckResourceAttr("netbox_device.test", "airflow", ""),
+					resource.TestCheckResourceAttr("netbox_device.test", "latitude", "0"),
+					resource.TestCheckResourceAttr("netbox_device.test", "longitude", "0"),
 					resource.TestCheckResourceAttr("netbox_device.test", "local_context_data", "{\"context_string\":\"context_value2\"}"),
 				),
 			},
@@ -257,6 +265,86 @@ resource "netbox_device" "test" {
 	})
 }
 
+func TestAccNetboxDevice_oobIP(t *testing.T) {
+	testSlug := "device_oob_ip"
+	testName := testAccGetTestName(testSlug)
+	// The interface is looked up by a data source, as a reference to the
+	// interface resource would be a dependency cycle.
+	oobIP := fmt.Sprintf(`
+resource "netbox_device_interface" "test" {
+  name = "mgmt0"
+  type = "1000base-t"
+  mgmtonly = true
+  device_id = netbox_device.test.id
+}
+
+data "netbox_device_interfaces" "test" {
+  filter {
+    name = "device"
+    value = "%[1]s"
+  }
+  filter {
+    name = "name"
+    value = "mgmt0"
+  }
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "1.1.1.121/32"
+  status = "active"
+  device_interface_id = one(data.netbox_device_interfaces.test.interfaces).id
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name = "mgmt0"
+  type = "1000base-t"
+  mgmtonly = true
+  device_id = netbox_device.test.id
+}`, testName),
+			},
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + oobIP + fmt.Sprintf(`
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id = netbox_site.test.id
+  oob_ip_id = netbox_ip_address.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device.test", "oob_ip_id", "netbox_ip_address.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + oobIP + fmt.Sprintf(`
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  site_id = netbox_site.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.test", "oob_ip_id", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxDevice_virtual_chassis(t *testing.T) {
 	testSlug := "device_virtual_chassis"
 	testName := testAccGetTestName(testSlug)
@@ -280,12 +368,14 @@ resource "netbox_device" "test" {
   platform_id = netbox_platform.test.id
   virtual_chassis_id = netbox_virtual_chassis.test.id
   virtual_chassis_position = 1
+  virtual_chassis_priority = 255
   virtual_chassis_master = true
 }`, testName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("netbox_virtual_chassis.test", "id", "netbox_device.test", "virtual_chassis_id"),
 					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_master", "true"),
 					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_position", "1"),
+					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_priority", "255"),
 				),
 			},
 			{
@@ -302,11 +392,14 @@ resource "netbox_device" "test" {
   site_id = netbox_site.test.id
   platform_id = netbox_platform.test.id
   virtual_chassis_id = netbox_virtual_chassis.test.id
-  virtual_chassis_position = 1
+  virtual_chassis_position = 2
+  virtual_chassis_priority = 100
   virtual_chassis_master = false
 }`, testName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_master", "false"),
+					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_position", "2"),
+					resource.TestCheckResourceAttr("netbox_device.test", "virtual_chassis_priority", "100"),
 				),
 			},
 			{
@@ -359,7 +452,7 @@ resource "netbox_device" "test" {
 
 func testAccCheckDeviceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each device
 	// is destroyed
@@ -399,7 +492,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimDevicesListParams()
 			res, err := api.Dcim.DcimDevicesList(params, nil)
 			if err != nil {
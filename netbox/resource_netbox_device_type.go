@@ -1,15 +1,38 @@
 package netbox
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var resourceNetboxDeviceTypeSubdeviceRoleOptions = []string{"parent", "child"}
+
+// writableDeviceType adds the fields to the go-netbox model that it does not
+// know and always sends the optional fields, so they can be unset.
+type writableDeviceType struct {
+	models.WritableDeviceType
+	Airflow                string   `json:"airflow"`
+	Weight                 *float64 `json:"weight"`
+	WeightUnit             string   `json:"weight_unit"`
+	SubdeviceRole          string   `json:"subdevice_role"`
+	ExcludeFromUtilization bool     `json:"exclude_from_utilization"`
+	DefaultPlatform        *int64   `json:"default_platform"`
+}
+
+// deviceType adds the fields to the go-netbox model that it does not know.
+type deviceType struct {
+	models.DeviceType
+	ExcludeFromUtilization bool                   `json:"exclude_from_utilization"`
+	DefaultPlatform        *models.NestedPlatform `json:"default_platform"`
+}
+
 func resourceNetboxDeviceType() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxDeviceTypeCreate,
@@ -21,7 +44,7 @@ func resourceNetboxDeviceType() *schema.Resource {
 
 > A device type represents a particular make and model of hardware that exists in the real world. Device types define the physical attributes of a device (rack height and depth) and its individual components (console, power, network interfaces, and so on).`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"model": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,7 +53,7 @@ func resourceNetboxDeviceType() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"manufacturer_id": {
 				Type:     schema.TypeInt,
@@ -49,8 +72,61 @@ func resourceNetboxDeviceType() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			"airflow": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceAirflowOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceAirflowOptions),
+			},
+			"weight": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"weight"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxRackWeightUnitOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxRackWeightUnitOptions),
+			},
+			"subdevice_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeSubdeviceRoleOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeSubdeviceRoleOptions) + ". Parent devices house child devices in device bays, child devices must be installed in a device bay and have a `u_height` of `0`.",
+			},
+			"exclude_from_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, devices of this type are not counted in the utilization of their rack.",
+			},
+			"default_platform_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The platform of new devices of this type, unless they specify one.",
+			},
+			"front_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a local image file that is uploaded as front image. The image is only uploaded again if the path changes, not if the content of the file changes.",
+			},
+			"front_image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rear_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a local image file that is uploaded as rear image. The image is only uploaded again if the path changes, not if the content of the file changes.",
+			},
+			"rear_image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,7 +134,7 @@ func resourceNetboxDeviceType() *schema.Resource {
 }
 
 func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableDeviceType{}
 
@@ -92,51 +168,99 @@ func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimDeviceTypesCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimDeviceTypesCreate(params, nil)
+	res, err := api.Dcim.DcimDeviceTypesCreate(params, nil, withRequestBody(getDeviceTypeBody(d, data)))
 	if err != nil {
 		return err
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	if err := updateDeviceTypeImages(api, d); err != nil {
+		return err
+	}
+
 	return resourceNetboxDeviceTypeRead(d, m)
 }
 
 func resourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceTypesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimDeviceTypesRead(params, nil)
+	api := m.(*providerState)
 
+	res, err := apiRequest(api, http.MethodGet, "/dcim/device-types/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimDeviceTypesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	deviceType := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var deviceType deviceType
+	if err := json.Unmarshal(payload, &deviceType); err != nil {
+		return err
+	}
+
 	d.Set("model", deviceType.Model)
 	d.Set("slug", deviceType.Slug)
 	d.Set("manufacturer_id", deviceType.Manufacturer.ID)
 	d.Set("part_number", deviceType.PartNumber)
 	d.Set("u_height", deviceType.UHeight)
 	d.Set("is_full_depth", deviceType.IsFullDepth)
-	d.Set(tagsKey, getTagListFromNestedTagList(deviceType.Tags))
 
+	if deviceType.Airflow != nil && deviceType.Airflow.Value != nil {
+		d.Set("airflow", deviceType.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+
+	d.Set("weight", deviceType.Weight)
+
+	if deviceType.WeightUnit != nil && deviceType.WeightUnit.Value != nil {
+		d.Set("weight_unit", deviceType.WeightUnit.Value)
+	} else {
+		d.Set("weight_unit", nil)
+	}
+
+	if deviceType.SubdeviceRole != nil && deviceType.SubdeviceRole.Value != nil {
+		d.Set("subdevice_role", deviceType.SubdeviceRole.Value)
+	} else {
+		d.Set("subdevice_role", nil)
+	}
+
+	d.Set("exclude_from_utilization", deviceType.ExcludeFromUtilization)
+
+	if deviceType.DefaultPlatform != nil {
+		d.Set("default_platform_id", deviceType.DefaultPlatform.ID)
+	} else {
+		d.Set("default_platform_id", nil)
+	}
+
+	d.Set("front_image_url", deviceType.FrontImage.String())
+	d.Set("rear_image_url", deviceType.RearImage.String())
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, deviceType.Tags))
+
+	cf := getCustomFields(api, deviceType.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, &deviceType.DeviceType)
 	return nil
 }
 
 func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableDeviceType{}
@@ -171,18 +295,26 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimDeviceTypesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimDeviceTypesPartialUpdate(params, nil, withRequestBody(getDeviceTypeBody(d, data)))
 	if err != nil {
 		return err
 	}
 
+	if err := updateDeviceTypeImages(api, d); err != nil {
+		return err
+	}
+
 	return resourceNetboxDeviceTypeRead(d, m)
 }
 
 func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
@@ -199,3 +331,48 @@ func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error
 	}
 	return nil
 }
+
+// getDeviceTypeBody returns the request body of the device type with the
+// fields go-netbox does not know.
+func getDeviceTypeBody(d *schema.ResourceData, data models.WritableDeviceType) *writableDeviceType {
+	return &writableDeviceType{
+		WritableDeviceType:     data,
+		Airflow:                d.Get("airflow").(string),
+		Weight:                 getOptionalFloat(d, "weight"),
+		WeightUnit:             d.Get("weight_unit").(string),
+		SubdeviceRole:          d.Get("subdevice_role").(string),
+		ExcludeFromUtilization: d.Get("exclude_from_utilization").(bool),
+		DefaultPlatform:        getOptionalInt(d, "default_platform_id"),
+	}
+}
+
+// updateDeviceTypeImages uploads the front and rear image of the device type
+// if their paths changed. An image whose path was removed is removed from
+// the device type.
+func updateDeviceTypeImages(api *providerState, d *schema.ResourceData) error {
+	files := map[string]string{}
+	removed := map[string]interface{}{}
+	for _, key := range []string{"front_image", "rear_image"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		if path := d.Get(key).(string); path != "" {
+			files[key] = path
+		} else {
+			removed[key] = nil
+		}
+	}
+
+	path := "/dcim/device-types/" + d.Id() + "/"
+	if len(files) > 0 {
+		if _, err := apiUpload(api, http.MethodPatch, path, files); err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := apiRequest(api, http.MethodPatch, path, nil, removed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,449 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// deviceTypeImportFields are the attributes of a device type that are taken
+// from the document, with the values that are sent when they are missing.
+var deviceTypeImportFields = map[string]interface{}{
+	"part_number":              "",
+	"u_height":                 1,
+	"is_full_depth":            true,
+	"airflow":                  "",
+	"weight":                   nil,
+	"weight_unit":              "",
+	"subdevice_role":           "",
+	"exclude_from_utilization": false,
+	"description":              "",
+	"comments":                 "",
+}
+
+// deviceTypeImportComponents are the sections of the component templates of
+// a document, in the order they are created. Templates that are referenced
+// by others, e.g. rear ports by front ports, come first.
+var deviceTypeImportComponents = []struct {
+	key  string
+	path string
+	// references maps the fields that reference other templates by name
+	// to the section of the referenced templates.
+	references map[string]string
+}{
+	{key: "console-ports", path: "/dcim/console-port-templates/"},
+	{key: "console-server-ports", path: "/dcim/console-server-port-templates/"},
+	{key: "power-ports", path: "/dcim/power-port-templates/"},
+	{key: "power-outlets", path: "/dcim/power-outlet-templates/", references: map[string]string{"power_port": "power-ports"}},
+	{key: "interfaces", path: "/dcim/interface-templates/"},
+	{key: "rear-ports", path: "/dcim/rear-port-templates/"},
+	{key: "front-ports", path: "/dcim/front-port-templates/", references: map[string]string{"rear_port": "rear-ports"}},
+	{key: "device-bays", path: "/dcim/device-bay-templates/"},
+	{key: "module-bays", path: "/dcim/module-bay-templates/"},
+	{key: "inventory-items", path: "/dcim/inventory-item-templates/"},
+}
+
+func resourceNetboxDeviceTypeImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeImportCreate,
+		Read:   resourceNetboxDeviceTypeImportRead,
+		Update: resourceNetboxDeviceTypeImportUpdate,
+		Delete: resourceNetboxDeviceTypeImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxDeviceTypeImportImport,
+		},
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):Manages a device type and all of its component templates from a document in the format of the [community device type library](https://github.com/netbox-community/devicetype-library), like the device type import of Netbox.
+
+The templates are identified by their name within their section, e.g. ` + "`interfaces`" + `, so renaming a template deletes the old template and creates a new one. Templates that are added to the device type outside of the document are deleted. The ID of the resource is the ID of the device type, so it can be used as ` + "`device_type_id`" + ` of devices.
+
+Existing device types can be imported with all of their component templates. The document is not part of the imported state, so the first apply updates the device type and its templates to match it.`,
+
+		Schema: map[string]*schema.Schema{
+			"yaml": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDeviceTypeImportYAML,
+				Description:  "The device type in the YAML format of the device type library, e.g. `file(\"Cisco/C9300-48P.yaml\")`.",
+			},
+			"manufacturer_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The manufacturer of the device type. By default, the manufacturer with the name of `manufacturer` of the document is used, which must exist.",
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_template_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the component templates, by section and name, e.g. `interfaces/GigabitEthernet1/0/1`.",
+			},
+		},
+	}
+}
+
+// parseDeviceTypeImportYAML returns the device type of the given document of
+// the device type library.
+func parseDeviceTypeImportYAML(document string) (map[string]interface{}, error) {
+	var deviceType map[string]interface{}
+	if err := yaml.Unmarshal([]byte(document), &deviceType); err != nil {
+		return nil, err
+	}
+	if deviceType == nil {
+		return nil, errors.New("the document is empty")
+	}
+	for _, key := range []string{"manufacturer", "model"} {
+		if value, ok := deviceType[key].(string); !ok || value == "" {
+			return nil, fmt.Errorf("the document has no %s", key)
+		}
+	}
+	for _, component := range deviceTypeImportComponents {
+		if _, err := getDeviceTypeImportTemplates(deviceType, component.key); err != nil {
+			return nil, err
+		}
+	}
+	return deviceType, nil
+}
+
+func validateDeviceTypeImportYAML(v interface{}, k string) ([]string, []error) {
+	if _, err := parseDeviceTypeImportYAML(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid device type: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// getDeviceTypeImportTemplates returns the templates of the given section of
+// the device type, each of which must have a name.
+func getDeviceTypeImportTemplates(deviceType map[string]interface{}, key string) ([]map[string]interface{}, error) {
+	value, ok := deviceType[key]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", key)
+	}
+
+	var templates []map[string]interface{}
+	names := map[string]bool{}
+	for _, element := range list {
+		template, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the elements of %s must be maps", key)
+		}
+		name, ok := template["name"].(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("an element of %s has no name", key)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("%s contains %q more than once", key, name)
+		}
+		names[name] = true
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// getDeviceTypeImportBody returns the request body of the device type of the
+// document. Attributes missing from the document are reset to their defaults.
+func getDeviceTypeImportBody(deviceType map[string]interface{}, manufacturerID int) map[string]interface{} {
+	model := deviceType["model"].(string)
+	body := map[string]interface{}{
+		"manufacturer": manufacturerID,
+		"model":        model,
+		"slug":         getSlug(model),
+	}
+	if slug, ok := deviceType["slug"].(string); ok && slug != "" {
+		body["slug"] = slug
+	}
+	for key, value := range deviceTypeImportFields {
+		body[key] = value
+		if value, ok := deviceType[key]; ok && value != nil {
+			body[key] = value
+		}
+	}
+	return body
+}
+
+// getDeviceTypeImportManufacturerID returns the ID of the manufacturer with
+// the given name.
+func getDeviceTypeImportManufacturerID(api *providerState, name string) (int, error) {
+	results, err := apiList(api, "/dcim/manufacturers/", url.Values{"name": {name}}, 2, 0)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) != 1 {
+		return 0, fmt.Errorf("expected one manufacturer named %q, found %d", name, len(results))
+	}
+	id, _ := results[0].(map[string]interface{})["id"].(json.Number)
+	return jsonNumberToInt(id), nil
+}
+
+// getDeviceTypeImportManufacturer returns the configured manufacturer, or
+// else the manufacturer named in the document.
+func getDeviceTypeImportManufacturer(api *providerState, d *schema.ResourceData, deviceType map[string]interface{}) (int, error) {
+	if !d.GetRawConfig().GetAttr("manufacturer_id").IsNull() {
+		return d.Get("manufacturer_id").(int), nil
+	}
+	return getDeviceTypeImportManufacturerID(api, deviceType["manufacturer"].(string))
+}
+
+// getDeviceTypeImportTemplateIDs returns the IDs of the existing templates of
+// the device type in the given section by name.
+func getDeviceTypeImportTemplateIDs(api *providerState, path, deviceTypeID string) (map[string]int, error) {
+	results, err := apiList(api, path, url.Values{"device_type_id": {deviceTypeID}}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]int{}
+	for _, result := range results {
+		template, _ := result.(map[string]interface{})
+		name, _ := template["name"].(string)
+		id, _ := template["id"].(json.Number)
+		ids[name] = jsonNumberToInt(id)
+	}
+	return ids, nil
+}
+
+// syncDeviceTypeImportTemplates creates, updates and deletes the component
+// templates of the device type to match the document.
+func syncDeviceTypeImportTemplates(api *providerState, d *schema.ResourceData, deviceType map[string]interface{}) error {
+	deviceTypeID, _ := strconv.Atoi(d.Id())
+
+	templateIDs := map[string]interface{}{}
+	idsByKey := map[string]map[string]int{}
+	remove := make([][]map[string]interface{}, len(deviceTypeImportComponents))
+
+	for i, component := range deviceTypeImportComponents {
+		existing, err := getDeviceTypeImportTemplateIDs(api, component.path, d.Id())
+		if err != nil {
+			return err
+		}
+		templates, err := getDeviceTypeImportTemplates(deviceType, component.key)
+		if err != nil {
+			return err
+		}
+
+		var create, update []map[string]interface{}
+		desired := map[string]bool{}
+		for _, template := range templates {
+			object := map[string]interface{}{}
+			for key, value := range template {
+				object[key] = value
+			}
+			object["device_type"] = deviceTypeID
+			for field, referencedKey := range component.references {
+				name, ok := object[field].(string)
+				if !ok {
+					continue
+				}
+				id, ok := idsByKey[referencedKey][name]
+				if !ok {
+					return fmt.Errorf("%s %q references the unknown %s %q", component.key, object["name"], referencedKey, name)
+				}
+				object[field] = id
+			}
+			if field := "manufacturer"; component.key == "inventory-items" {
+				if name, ok := object[field].(string); ok {
+					id, err := getDeviceTypeImportManufacturerID(api, name)
+					if err != nil {
+						return err
+					}
+					object[field] = id
+				}
+			}
+
+			name := object["name"].(string)
+			desired[name] = true
+			if id, ok := existing[name]; ok {
+				object["id"] = id
+				update = append(update, object)
+			} else {
+				create = append(create, object)
+			}
+		}
+		for name, id := range existing {
+			if !desired[name] {
+				remove[i] = append(remove[i], map[string]interface{}{"id": id})
+			}
+		}
+
+		ids := map[string]int{}
+		for name, id := range existing {
+			if desired[name] {
+				ids[name] = id
+			}
+		}
+		if _, err := apiBulkRequest(api, http.MethodPatch, component.path, update, 100); err != nil {
+			return err
+		}
+		created, err := apiBulkRequest(api, http.MethodPost, component.path, create, 100)
+		if err != nil {
+			return err
+		}
+		for _, result := range created {
+			template, _ := result.(map[string]interface{})
+			name, _ := template["name"].(string)
+			id, _ := template["id"].(json.Number)
+			ids[name] = jsonNumberToInt(id)
+		}
+		idsByKey[component.key] = ids
+		for name, id := range ids {
+			templateIDs[component.key+"/"+name] = id
+		}
+	}
+
+	// Templates are removed last and in reverse order, so that they are no
+	// longer referenced by other templates.
+	for i := len(deviceTypeImportComponents) - 1; i >= 0; i-- {
+		if _, err := apiBulkRequest(api, http.MethodDelete, deviceTypeImportComponents[i].path, remove[i], 100); err != nil {
+			return err
+		}
+	}
+
+	d.Set("component_template_ids", templateIDs)
+	return nil
+}
+
+func resourceNetboxDeviceTypeImportCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	deviceType, err := parseDeviceTypeImportYAML(d.Get("yaml").(string))
+	if err != nil {
+		return err
+	}
+
+	manufacturerID, err := getDeviceTypeImportManufacturer(api, d, deviceType)
+	if err != nil {
+		return err
+	}
+
+	res, err := apiRequest(api, http.MethodPost, "/dcim/device-types/", nil, getDeviceTypeImportBody(deviceType, manufacturerID))
+	if err != nil {
+		return err
+	}
+
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
+
+	if err := syncDeviceTypeImportTemplates(api, d, deviceType); err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeImportRead(d, m)
+}
+
+// resourceNetboxDeviceTypeImportImport imports a device type with the IDs of
+// its existing component templates.
+func resourceNetboxDeviceTypeImportImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	api := m.(*providerState)
+
+	templateIDs := map[string]interface{}{}
+	for _, component := range deviceTypeImportComponents {
+		ids, err := getDeviceTypeImportTemplateIDs(api, component.path, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		for name, id := range ids {
+			templateIDs[component.key+"/"+name] = id
+		}
+	}
+	d.Set("component_template_ids", templateIDs)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNetboxDeviceTypeImportRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := apiRequest(api, http.MethodGet, "/dcim/device-types/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var deviceType deviceType
+	if err := json.Unmarshal(payload, &deviceType); err != nil {
+		return err
+	}
+
+	d.Set("model", deviceType.Model)
+	d.Set("slug", deviceType.Slug)
+	if deviceType.Manufacturer != nil {
+		d.Set("manufacturer_id", deviceType.Manufacturer.ID)
+	}
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeImportUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	deviceType, err := parseDeviceTypeImportYAML(d.Get("yaml").(string))
+	if err != nil {
+		return err
+	}
+
+	manufacturerID, err := getDeviceTypeImportManufacturer(api, d, deviceType)
+	if err != nil {
+		return err
+	}
+
+	if _, err := apiRequest(api, http.MethodPatch, "/dcim/device-types/"+d.Id()+"/", nil, getDeviceTypeImportBody(deviceType, manufacturerID)); err != nil {
+		return err
+	}
+
+	if err := syncDeviceTypeImportTemplates(api, d, deviceType); err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeImportRead(d, m)
+}
+
+func resourceNetboxDeviceTypeImportDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
+
+	// Netbox deletes the component templates along with the device type
+	_, err := api.Dcim.DcimDeviceTypesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceTypesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
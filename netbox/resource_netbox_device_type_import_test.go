@@ -0,0 +1,185 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccNetboxDeviceTypeImportConfig(testName, components string) string {
+	return fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type_import" "test" {
+  yaml = <<-EOT
+    manufacturer: ${netbox_manufacturer.test.name}
+    model: %[1]s
+    slug: %[1]s
+    u_height: 2
+    is_full_depth: false
+    airflow: front-to-rear
+%[2]s
+  EOT
+}`, testName, components)
+}
+
+func TestAccNetboxDeviceTypeImport_basic(t *testing.T) {
+	testSlug := "device_type_import"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceTypeImportConfig(testName, `
+    power-ports:
+      - name: PSU1
+        type: iec-60320-c14
+        maximum_draw: 400
+    power-outlets:
+      - name: Outlet1
+        type: iec-60320-c13
+        power_port: PSU1
+    interfaces:
+      - name: eth0
+        type: 1000base-t
+        mgmt_only: true
+      - name: eth1
+        type: 10gbase-x-sfpp
+    rear-ports:
+      - name: Rear1
+        type: 8p8c
+        positions: 1
+    front-ports:
+      - name: Front1
+        type: 8p8c
+        rear_port: Rear1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type_import.test", "model", testName),
+					resource.TestCheckResourceAttr("netbox_device_type_import.test", "slug", testName),
+					resource.TestCheckResourceAttrPair("netbox_device_type_import.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_type_import.test", "component_template_ids.%", "6"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.power-outlets/Outlet1"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.interfaces/eth1"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.front-ports/Front1"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceTypeImportConfig(testName, `
+    power-ports:
+      - name: PSU1
+        type: iec-60320-c14
+        maximum_draw: 500
+    interfaces:
+      - name: eth0
+        type: 1000base-t
+        mgmt_only: true
+      - name: eth2
+        type: 10gbase-x-sfpp
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type_import.test", "component_template_ids.%", "3"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.power-ports/PSU1"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.interfaces/eth0"),
+					resource.TestCheckResourceAttrSet("netbox_device_type_import.test", "component_template_ids.interfaces/eth2"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_type_import.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"yaml"},
+			},
+		},
+	})
+}
+
+func TestParseDeviceTypeImportYAML(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{
+			name: "valid",
+			document: `
+manufacturer: Cisco
+model: C9300-48P
+interfaces:
+  - name: GigabitEthernet1/0/1
+    type: 1000base-t
+`,
+		},
+		{
+			name:     "empty",
+			document: "",
+			err:      "the document is empty",
+		},
+		{
+			name:     "without model",
+			document: "manufacturer: Cisco",
+			err:      "the document has no model",
+		},
+		{
+			name: "without template name",
+			document: `
+manufacturer: Cisco
+model: C9300-48P
+interfaces:
+  - type: 1000base-t
+`,
+			err: "an element of interfaces has no name",
+		},
+		{
+			name: "duplicate template name",
+			document: `
+manufacturer: Cisco
+model: C9300-48P
+console-ports:
+  - name: con0
+  - name: con0
+`,
+			err: `console-ports contains "con0" more than once`,
+		},
+		{
+			name:     "templates not a list",
+			document: "manufacturer: Cisco\nmodel: C9300-48P\ninterfaces: eth0",
+			err:      "interfaces must be a list",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseDeviceTypeImportYAML(strings.TrimSpace(tt.document))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestGetDeviceTypeImportBody(t *testing.T) {
+	deviceType, err := parseDeviceTypeImportYAML(`
+manufacturer: Cisco
+model: C9300 48P
+u_height: 0.5
+front_image: true
+`)
+	assert.NoError(t, err)
+
+	body := getDeviceTypeImportBody(deviceType, 4)
+	assert.Equal(t, 4, body["manufacturer"])
+	assert.Equal(t, "C9300 48P", body["model"])
+	assert.Equal(t, getSlug("C9300 48P"), body["slug"])
+	assert.Equal(t, 0.5, body["u_height"])
+	assert.Equal(t, true, body["is_full_depth"])
+	assert.Equal(t, "", body["airflow"])
+	assert.Nil(t, body["weight"])
+	assert.NotContains(t, body, "front_image")
+}
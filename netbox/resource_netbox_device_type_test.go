@@ -2,11 +2,15 @@ package netbox
 
 import (
 	"fmt"
+	"image"
+	"image/png"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -74,6 +78,83 @@ resource "netbox_device_type" "test" {
 	})
 }
 
+func TestAccNetboxDeviceType_full(t *testing.T) {
+	testSlug := "device_type_full"
+	testName := testAccGetTestName(testSlug)
+
+	imagePath := filepath.Join(t.TempDir(), "front.png")
+	imageFile, err := os.Create(imagePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(imageFile, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	imageFile.Close()
+
+	dependencies := fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  airflow = "front-to-rear"
+  weight = 12.5
+  weight_unit = "kg"
+  subdevice_role = "parent"
+  exclude_from_utilization = true
+  default_platform_id = netbox_platform.test.id
+  front_image = "%[2]s"
+}`, testName, imagePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", "front-to-rear"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "12.5"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", "kg"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", "parent"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "exclude_from_utilization", "true"),
+					resource.TestCheckResourceAttrPair("netbox_device_type.test", "default_platform_id", "netbox_platform.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image", imagePath),
+					resource.TestMatchResourceAttr("netbox_device_type.test", "front_image_url", regexp.MustCompile(`front.*\.png$`)),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "rear_image_url", ""),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "exclude_from_utilization", "false"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "default_platform_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image_url", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_device_type", &resource.Sweeper{
 		Name:         "netbox_device_type",
@@ -83,7 +164,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimDeviceTypesListParams()
 			res, err := api.Dcim.DcimDeviceTypesList(params, nil)
 			if err != nil {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,7 +23,7 @@ func resourceNetboxEventRule() *schema.Resource {
 
 > NetBox can be configured via Event Rules to transmit outgoing webhooks to remote systems in response to internal object changes. The receiver can act on the data in these webhook messages to perform related tasks.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -87,8 +86,9 @@ func resourceNetboxEventRule() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -96,7 +96,7 @@ func resourceNetboxEventRule() *schema.Resource {
 }
 
 func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := &models.WritableEventRule{}
 
@@ -142,6 +142,10 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 		data.Conditions = conditions
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := extras.NewExtrasEventRulesCreateParams().WithData(data)
 
 	res, err := api.Extras.ExtrasEventRulesCreate(params, nil)
@@ -155,7 +159,7 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasEventRulesReadParams().WithID(id)
 
@@ -193,13 +197,19 @@ func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("conditions", string(conditions))
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(eventRule.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, eventRule.Tags))
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableEventRule{}
@@ -246,9 +256,13 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 	}
 	data.ObjectTypes = objectTypes
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := extras.NewExtrasEventRulesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasEventRulesUpdate(params, nil)
+	_, err := api.Extras.ExtrasEventRulesUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -257,7 +271,7 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxEventRuleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasEventRulesDeleteParams().WithID(id)
@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxFrontPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxFrontPortTemplateCreate,
+		ReadContext:   resourceNetboxFrontPortTemplateRead,
+		UpdateContext: resourceNetboxFrontPortTemplateUpdate,
+		DeleteContext: resourceNetboxFrontPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/frontporttemplate/):
+
+> A template for a front-facing pass-through port that will be created on all instantiations of the parent device type. See the front port documentation for more detail.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDevicePortTypeOptions),
+				Description:      buildValidValueDescription(resourceNetboxDevicePortTypeOptions),
+			},
+			"color_hex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateColorHex,
+			},
+			"rear_port_template_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The rear port template of the same device or module type that the port maps to.",
+			},
+			"rear_port_position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1024),
+				Description:  "The position on the rear port that the port maps to.",
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFrontPortTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritableFrontPortTemplate{
+		Name:             strToPtr(d.Get("name").(string)),
+		Description:      d.Get("description").(string),
+		Label:            d.Get("label").(string),
+		Type:             strToPtr(d.Get("type").(string)),
+		Color:            d.Get("color_hex").(string),
+		RearPort:         int64ToPtr(int64(d.Get("rear_port_template_id").(int))),
+		RearPortPosition: int64(d.Get("rear_port_position").(int)),
+		DeviceType:       getOptionalInt(d, "device_type_id"),
+		ModuleType:       getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimFrontPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFrontPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxFrontPortTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimFrontPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimFrontPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimFrontPortTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+
+	d.Set("color_hex", tmpl.Color)
+
+	if tmpl.RearPort != nil {
+		d.Set("rear_port_template_id", tmpl.RearPort.ID)
+	} else {
+		d.Set("rear_port_template_id", nil)
+	}
+	d.Set("rear_port_position", tmpl.RearPortPosition)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxFrontPortTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableFrontPortTemplate{
+		Name:             strToPtr(d.Get("name").(string)),
+		Description:      d.Get("description").(string),
+		Label:            d.Get("label").(string),
+		Type:             strToPtr(d.Get("type").(string)),
+		Color:            d.Get("color_hex").(string),
+		RearPort:         int64ToPtr(int64(d.Get("rear_port_template_id").(int))),
+		RearPortPosition: int64(d.Get("rear_port_position").(int)),
+		DeviceType:       getOptionalInt(d, "device_type_id"),
+		ModuleType:       getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimFrontPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimFrontPortTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxFrontPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxFrontPortTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimFrontPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimFrontPortTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
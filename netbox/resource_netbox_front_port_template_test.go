@@ -0,0 +1,116 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestAccNetboxFrontPortTemplate_basic(t *testing.T) {
+	testSlug := "front_port_template"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+	name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+	model = "%[1]s"
+	slug = "%[1]s"
+	manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_raw_object" "rear_port_template" {
+	path = "dcim/rear-port-templates"
+	payload = jsonencode({
+		device_type = netbox_device_type.test.id
+		name        = "%[1]s"
+		type        = "lc"
+		positions   = 2
+	})
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_front_port_template" "test" {
+	name = "%[1]s"
+	description = "%[1]s description"
+	label = "%[1]s label"
+	type = "lc"
+	color_hex = "ff0000"
+	rear_port_template_id = netbox_raw_object.rear_port_template.id
+	device_type_id = netbox_device_type.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "description", fmt.Sprintf("%s description", testName)),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "label", fmt.Sprintf("%s label", testName)),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "color_hex", "ff0000"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "rear_port_position", "1"),
+					resource.TestCheckResourceAttrPair("netbox_front_port_template.test", "rear_port_template_id", "netbox_raw_object.rear_port_template", "id"),
+					resource.TestCheckResourceAttrPair("netbox_front_port_template.test", "device_type_id", "netbox_device_type.test", "id"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_front_port_template" "test" {
+	name = "%[1]s"
+	type = "lc"
+	rear_port_template_id = netbox_raw_object.rear_port_template.id
+	rear_port_position = 2
+	device_type_id = netbox_device_type.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "rear_port_position", "2"),
+				),
+			},
+			{
+				ResourceName:      "netbox_front_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_front_port_template", &resource.Sweeper{
+		Name:         "netbox_front_port_template",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			params := dcim.NewDcimFrontPortTemplatesListParams()
+			res, err := api.Dcim.DcimFrontPortTemplatesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, tmpl := range res.GetPayload().Results {
+				if strings.HasPrefix(*tmpl.Name, testPrefix) {
+					deleteParams := dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(tmpl.ID)
+					_, err := api.Dcim.DcimFrontPortTemplatesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a front port template")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -1,108 +1,260 @@
 package netbox
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
-func resourceNetboxGroup() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNetboxGroupCreate,
-		Read:   resourceNetboxGroupRead,
-		Update: resourceNetboxGroupUpdate,
-		Delete: resourceNetboxGroupDelete,
+// groupResource is the resource netbox_group. It is the first resource that
+// is implemented with terraform-plugin-framework and serves as the template
+// for migrating the SDKv2 resources.
+type groupResource struct {
+	api *providerState
+}
+
+type groupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Branch      types.String `tfsdk:"branch"`
+	URL         types.String `tfsdk:"url"`
+	Display     types.String `tfsdk:"display"`
+	Created     types.String `tfsdk:"created"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
 
-		Description: `:meta:subcategory:Authentication:This resource is used to manage groups.`,
+var (
+	_ resource.ResourceWithConfigure    = &groupResource{}
+	_ resource.ResourceWithImportState  = &groupResource{}
+	_ resource.ResourceWithUpgradeState = &groupResource{}
+)
+
+// NewGroupResource returns the resource netbox_group.
+func NewGroupResource() resource.Resource {
+	return &groupResource{}
+}
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
+func (r *groupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *groupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 0 is the schema of the SDKv2 resource
+		Version:             1,
+		MarkdownDescription: `:meta:subcategory:Authentication:This resource is used to manage groups.`,
+		Attributes: frameworkObjectMetadataAttributes(map[string]schema.Attribute{
+			"id": frameworkIDAttribute,
+			"name": schema.StringAttribute{
 				Required: true,
 			},
-		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+			branchKey: frameworkBranchAttribute,
+		}),
 	}
 }
-func resourceNetboxGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	data := models.Group{}
 
-	name := d.Get("name").(string)
+func (r *groupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.api = req.ProviderData.(*providerState)
+}
 
-	data.Name = &name
+func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data groupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, diags := frameworkBranchState(r.api, data.Branch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	params := users.NewUsersGroupsCreateParams().WithData(&data)
+	params := users.NewUsersGroupsCreateParamsWithContext(ctx).WithData(&models.Group{
+		Name: data.Name.ValueStringPointer(),
+	})
 	res, err := api.Users.UsersGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		resp.Diagnostics.Append(frameworkDiagnostics(diag.FromErr(err), "name")...)
+		return
 	}
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	data.ID = types.StringValue(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxGroupRead(d, m)
+	if _, diags := r.read(ctx, api, &data); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func resourceNetboxGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersGroupsReadParams().WithID(id)
+func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data groupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, diags := frameworkBranchState(r.api, data.Branch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	res, err := api.Users.UsersGroupsRead(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*users.UsersGroupsReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+	found, diags := r.read(ctx, api, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddWarning("Object not found in Netbox", fmt.Sprintf("The object with ID %s no longer exists in Netbox, it was probably deleted outside of Terraform. It has been removed from the state.", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state groupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, diags := frameworkBranchState(r.api, data.Branch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if api.detectExternalModifications {
+		resp.Diagnostics.Append(frameworkDiagnostics(checkExternalModification(api, state.URL.ValueString(), state.LastUpdated.ValueString()))...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		return err
 	}
 
-	if res.GetPayload().Name != nil {
-		d.Set("name", res.GetPayload().Name)
+	id, _ := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	params := users.NewUsersGroupsUpdateParamsWithContext(ctx).WithID(id).WithData(&models.Group{
+		Name: data.Name.ValueStringPointer(),
+	})
+	resp.Diagnostics.Append(frameworkDiagnostics(retryOperation(ctx, state.ID.ValueString(), func() (diag.Diagnostics, bool) {
+		_, err := api.Users.UsersGroupsUpdate(params, nil)
+		return diag.FromErr(err), true
+	}), "name")...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return nil
+	if _, diags := r.read(ctx, api, &data); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func resourceNetboxGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	data := models.Group{}
+func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data groupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api, diags := frameworkBranchState(r.api, data.Branch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	name := d.Get("name").(string)
+	id, _ := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	params := users.NewUsersGroupsDeleteParamsWithContext(ctx).WithID(id)
+	resp.Diagnostics.Append(frameworkDiagnostics(retryOperation(ctx, data.ID.ValueString(), func() (diag.Diagnostics, bool) {
+		_, err := api.Users.UsersGroupsDelete(params, nil)
+		if errresp, ok := err.(*users.UsersGroupsDeleteDefault); ok && errresp.Code() == 404 {
+			return nil, true
+		}
+		return diag.FromErr(err), true
+	}))...)
+}
 
-	data.Name = &name
+// ImportState imports a group by its ID or by filters, like the SDKv2
+// resources wrapped by wrapImportByFilter.
+func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	api, diags := frameworkBranchState(r.api, types.StringNull())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	params := users.NewUsersGroupsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersGroupsUpdate(params, nil)
+	id, err := importIDByFilter(api, importPaths["netbox_group"], req.ID)
 	if err != nil {
-		return err
+		resp.Diagnostics.AddError("Error importing group", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// UpgradeState upgrades the states written by the SDKv2 resource.
+func (r *groupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state map[string]interface{}
+				if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+					resp.Diagnostics.AddError("Error upgrading state", err.Error())
+					return
+				}
+
+				data := groupResourceModel{
+					ID:          sdkStateString(state, "id"),
+					Name:        sdkStateString(state, "name"),
+					Branch:      sdkStateString(state, branchKey),
+					URL:         sdkStateString(state, "url"),
+					Display:     sdkStateString(state, "display"),
+					Created:     sdkStateString(state, "created"),
+					LastUpdated: sdkStateString(state, "last_updated"),
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
 	}
-	return resourceNetboxGroupRead(d, m)
 }
 
-func resourceNetboxGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersGroupsDeleteParams().WithID(id)
-	_, err := api.Users.UsersGroupsDelete(params, nil)
+// read updates data with the group in Netbox. It reports false if the group
+// no longer exists.
+func (r *groupResource) read(ctx context.Context, api *providerState, data *groupResourceModel) (bool, fwdiag.Diagnostics) {
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
 	if err != nil {
-		if errresp, ok := err.(*users.UsersGroupsDeleteDefault); ok {
-			if errresp.Code() == 404 {
-				d.SetId("")
-				return nil
-			}
+		var diags fwdiag.Diagnostics
+		diags.AddError("Invalid ID", fmt.Sprintf("The ID %q of the group is not a number.", data.ID.ValueString()))
+		return false, diags
+	}
+
+	params := users.NewUsersGroupsReadParamsWithContext(ctx).WithID(id)
+	res, err := api.Users.UsersGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*users.UsersGroupsReadDefault); ok && errresp.Code() == 404 {
+			return false, nil
 		}
-		return err
+		return false, frameworkDiagnostics(diag.FromErr(err))
 	}
-	d.SetId("")
-	return nil
+
+	group := res.GetPayload()
+	data.Name = types.StringPointerValue(group.Name)
+
+	metadata := getObjectMetadata(group)
+	data.URL = frameworkMetadataValue(metadata, "url")
+	data.Display = frameworkMetadataValue(metadata, "display")
+	data.Created = frameworkMetadataValue(metadata, "created")
+	data.LastUpdated = frameworkMetadataValue(metadata, "last_updated")
+	return true, nil
 }
@@ -1,22 +1,27 @@
 package netbox
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxGroup_basic(t *testing.T) {
 	testSlug := "groups"
 	testName := testAccGetTestName(testSlug)
 	resource.ParallelTest(t, resource.TestCase{
-		Providers: testAccProviders,
-		PreCheck:  func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
@@ -36,6 +41,139 @@ resource "netbox_group" "test_basic" {
 	})
 }
 
+func TestGroupResource(t *testing.T) {
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/users/groups/":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 3, "name": "ops"}`))
+		case r.Method == "GET" && r.URL.Path == "/api/users/groups/3/" && !deleted:
+			w.Write([]byte(`{"id": 3, "name": "ops", "display": "ops", "url": "https://netbox.example.com/api/users/groups/3/"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/users/groups/3/":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	server, schemaResp := testConfiguredProviderServer(t, ts.URL)
+	groupSchema := schemaResp.ResourceSchemas["netbox_group"]
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	null, err := tfprotov5.NewDynamicValue(groupSchema.ValueType(), tftypes.NewValue(groupSchema.ValueType(), nil))
+	assert.NoError(t, err)
+
+	createResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:   "netbox_group",
+		PriorState: &null,
+		Config: testDynamicValue(t, groupSchema, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "ops"),
+		}),
+		PlannedState: testDynamicValue(t, groupSchema, map[string]tftypes.Value{
+			"name":         tftypes.NewValue(tftypes.String, "ops"),
+			"id":           unknown,
+			"url":          unknown,
+			"display":      unknown,
+			"created":      unknown,
+			"last_updated": unknown,
+		}),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, createResp.Diagnostics)
+
+	state, err := createResp.NewState.Unmarshal(groupSchema.ValueType())
+	assert.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, state.As(&attributes))
+	var id, display string
+	assert.NoError(t, attributes["id"].As(&id))
+	assert.NoError(t, attributes["display"].As(&display))
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "ops", display)
+	assert.True(t, attributes["last_updated"].IsNull())
+
+	deleteResp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "netbox_group",
+		PriorState:   createResp.NewState,
+		PlannedState: &null,
+		Config:       &null,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, deleteResp.Diagnostics)
+	assert.True(t, deleted)
+
+	readResp, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "netbox_group",
+		CurrentState: createResp.NewState,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, readResp.Diagnostics, 1) {
+		assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, readResp.Diagnostics[0].Severity)
+	}
+	state, err = readResp.NewState.Unmarshal(groupSchema.ValueType())
+	assert.NoError(t, err)
+	assert.True(t, state.IsNull())
+}
+
+func TestGroupResourceUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	factory, err := ProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := factory()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	groupSchema := schemaResp.ResourceSchemas["netbox_group"]
+
+	// A state of the SDKv2 resource, which stores unset strings as ""
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "netbox_group",
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(`{"id": "3", "name": "ops", "branch": "", "url": "https://netbox.example.com/api/users/groups/3/", "display": "ops", "created": "", "last_updated": ""}`)},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+
+	state, err := resp.UpgradedState.Unmarshal(groupSchema.ValueType())
+	assert.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	assert.NoError(t, state.As(&attributes))
+	var id, name string
+	assert.NoError(t, attributes["id"].As(&id))
+	assert.NoError(t, attributes["name"].As(&name))
+	assert.Equal(t, "3", id)
+	assert.Equal(t, "ops", name)
+	for _, key := range []string{"branch", "created", "last_updated"} {
+		assert.True(t, attributes[key].IsNull(), key)
+	}
+
+	// The upgraded state must not plan a change for a configuration that
+	// leaves the attributes stored as "" unset
+	config := testDynamicValue(t, groupSchema, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "ops"),
+	})
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "netbox_group",
+		PriorState:       resp.UpgradedState,
+		ProposedNewState: resp.UpgradedState,
+		Config:           config,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, planResp.Diagnostics)
+	planned, err := planResp.PlannedState.Unmarshal(groupSchema.ValueType())
+	assert.NoError(t, err)
+	assert.True(t, planned.Equal(state))
+	assert.Empty(t, planResp.RequiresReplace)
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_group", &resource.Sweeper{
 		Name:         "netbox_group",
@@ -45,7 +183,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := users.NewUsersGroupsListParams()
 			res, err := api.Users.UsersGroupsList(params, nil)
 			if err != nil {
@@ -2,10 +2,11 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
-	"strings"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,17 +16,35 @@ import (
 
 var resourceNetboxInterfaceModeOptions = []string{"access", "tagged", "tagged-all"}
 
+// writableVMInterface adds the fields to the go-netbox model that it does not
+// know.
+// Mode and UntaggedVlan shadow the fields of the model, which are omitted
+// when empty, so they can be unset.
+type writableVMInterface struct {
+	models.WritableVMInterface
+	PrimaryMacAddress *int64 `json:"primary_mac_address,omitempty"`
+	Mode              string `json:"mode"`
+	UntaggedVlan      *int64 `json:"untagged_vlan"`
+}
+
+// vmInterface adds the fields to the go-netbox model that it does not know.
+type vmInterface struct {
+	models.VMInterface
+	PrimaryMacAddress *nestedMACAddress `json:"primary_mac_address"`
+}
+
 func resourceNetboxInterface() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetboxInterfaceCreate,
 		ReadContext:   resourceNetboxInterfaceRead,
 		UpdateContext: resourceNetboxInterfaceUpdate,
 		DeleteContext: resourceNetboxInterfaceDelete,
+		CustomizeDiff: validateInterfaceVLANs,
 
 		Description: `:meta:subcategory:Virtualization:From the [official documentation](https://docs.netbox.dev/en/stable/features/virtualization/#interfaces):
 
 > Virtual machine interfaces behave similarly to device interfaces, and can be assigned to VRFs, and may have IP addresses, VLANs, and services attached to them. However, given their virtual nature, they lack properties pertaining to physical attributes. For example, VM interfaces do not have a physical type and cannot have cables attached to them.`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -43,14 +62,12 @@ func resourceNetboxInterface() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
-			"mac_address": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsMACAddress,
-				// Netbox converts MAC addresses always to uppercase
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return strings.EqualFold(old, new)
-				},
+			"mac_address": interfaceMACAddressSchema,
+			"primary_mac_address": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the primary MAC address of the interface, which must be assigned to the interface. See also `primary` of `netbox_mac_address`. Requires Netbox 4.2 or later.",
 			},
 			"mode": {
 				Type:         schema.TypeString,
@@ -75,12 +92,15 @@ func resourceNetboxInterface() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The IDs of the tagged VLANs. Requires `mode` to be `tagged` or `tagged-all`.",
 			},
 			"untagged_vlan": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the untagged VLAN. Requires `mode` to be set.",
 			},
-		},
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -88,14 +108,13 @@ func resourceNetboxInterface() *schema.Resource {
 }
 
 func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
-	mode := d.Get("mode").(string)
 	tags, diagnostics := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
@@ -107,61 +126,72 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		Name:           &name,
 		Description:    description,
 		Enabled:        enabled,
-		Mode:           mode,
 		Tags:           tags,
 		TaggedVlans:    taggedVlans,
 		VirtualMachine: &virtualMachineID,
 	}
 	if macAddress := d.Get("mac_address").(string); macAddress != "" {
-		data.MacAddress = &macAddress
+		data.MacAddress = strToPtr(normalizeMACAddress(macAddress))
 	}
 	if mtu, ok := d.Get("mtu").(int); ok && mtu != 0 {
 		data.Mtu = int64ToPtr(int64(mtu))
 	}
-	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
-		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
-	params := virtualization.NewVirtualizationInterfacesCreateParams().WithData(&data)
 
-	res, err := api.Virtualization.VirtualizationInterfacesCreate(params, nil)
+	res, err := apiRequest(api, http.MethodPost, "/virtualization/interfaces/", nil, &writableVMInterface{
+		WritableVMInterface: data,
+		PrimaryMacAddress:   getOptionalInt(d, "primary_mac_address"),
+		Mode:                d.Get("mode").(string),
+		UntaggedVlan:        getOptionalInt(d, "untagged_vlan"),
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
 
 	return diags
 }
 
 func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	params := virtualization.NewVirtualizationInterfacesReadParams().WithID(id)
-
-	res, err := api.Virtualization.VirtualizationInterfacesRead(params, nil)
+	res, err := apiRequest(api, http.MethodGet, "/virtualization/interfaces/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*virtualization.VirtualizationInterfacesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
 
-	iface := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var iface vmInterface
+	if err := json.Unmarshal(payload, &iface); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.Set("name", iface.Name)
 	d.Set("description", iface.Description)
 	d.Set("enabled", iface.Enabled)
 	d.Set("mac_address", iface.MacAddress)
+	if iface.PrimaryMacAddress != nil {
+		d.Set("primary_mac_address", iface.PrimaryMacAddress.ID)
+	} else {
+		d.Set("primary_mac_address", nil)
+	}
 	d.Set("mtu", iface.Mtu)
-	d.Set(tagsKey, getTagListFromNestedTagList(iface.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, iface.Tags))
 	d.Set("tagged_vlans", getIDsFromNestedVLAN(iface.TaggedVlans))
 	d.Set("virtual_machine_id", iface.VirtualMachine.ID)
 
@@ -172,20 +202,23 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("untagged_vlan", iface.UntaggedVlan.ID)
 	}
 
+	cf := getCustomFields(api, iface.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, &iface.VMInterface)
 	return diags
 }
 
 func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
-	mode := d.Get("mode").(string)
 	tags, diagnostics := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
@@ -197,27 +230,39 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		Name:           &name,
 		Description:    description,
 		Enabled:        enabled,
-		Mode:           mode,
 		Tags:           tags,
 		TaggedVlans:    taggedVlans,
 		VirtualMachine: &virtualMachineID,
 	}
 
 	if d.HasChange("mac_address") {
-		macAddress := d.Get("mac_address").(string)
+		macAddress := normalizeMACAddress(d.Get("mac_address").(string))
 		data.MacAddress = &macAddress
 	}
 	if d.HasChange("mtu") {
 		mtu := int64(d.Get("mtu").(int))
 		data.Mtu = &mtu
 	}
-	if d.HasChange("untagged_vlan") {
-		untaggedvlan := int64(d.Get("untagged_vlan").(int))
-		data.UntaggedVlan = &untaggedvlan
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
+	body := &writableVMInterface{
+		WritableVMInterface: data,
+		Mode:                d.Get("mode").(string),
+		UntaggedVlan:        getOptionalInt(d, "untagged_vlan"),
+	}
+	if d.HasChange("primary_mac_address") {
+		body.PrimaryMacAddress = getOptionalInt(d, "primary_mac_address")
+	}
+
+	request, err := clearEmptyStrings(d, body, "description")
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	params := virtualization.NewVirtualizationInterfacesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Virtualization.VirtualizationInterfacesPartialUpdate(params, nil)
+	_, err = apiRequest(api, http.MethodPatch, "/virtualization/interfaces/"+d.Id()+"/", nil, request)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -226,7 +271,7 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNetboxInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationInterfacesDeleteParams().WithID(id)
@@ -2,9 +2,11 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,6 +14,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var resourceNetboxInterfaceTemplatePoeModeOptions = []string{"pd", "pse"}
+
+var resourceNetboxInterfaceTemplatePoeTypeOptions = []string{
+	"type1-ieee802.3af",
+	"type2-ieee802.3at",
+	"type2-ieee802.3az",
+	"type3-ieee802.3bt",
+	"type4-ieee802.3bt",
+	"passive-24v-2pair",
+	"passive-24v-4pair",
+	"passive-48v-2pair",
+	"passive-48v-4pair",
+}
+
+// writableInterfaceTemplate adds the fields to the go-netbox model that it
+// does not know or omits when they are empty, so they can be unset.
+type writableInterfaceTemplate struct {
+	models.WritableInterfaceTemplate
+	Enabled  bool    `json:"enabled"`
+	MgmtOnly bool    `json:"mgmt_only"`
+	PoeMode  *string `json:"poe_mode"`
+	PoeType  *string `json:"poe_type"`
+}
+
+// interfaceTemplate adds the fields to the go-netbox model that it does not
+// know.
+type interfaceTemplate struct {
+	models.InterfaceTemplate
+	Enabled bool `json:"enabled"`
+}
+
 func resourceNetboxInterfaceTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetboxInterfaceTemplateCreate,
@@ -22,7 +55,7 @@ func resourceNetboxInterfaceTemplate() *schema.Resource {
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/interfacetemplate/):
 
 > A template for a network interface that will be created on all instantiations of the parent device type. See the interface documentation for more detail.`,
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -44,6 +77,23 @@ func resourceNetboxInterfaceTemplate() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"poe_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInterfaceTemplatePoeModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxInterfaceTemplatePoeModeOptions),
+			},
+			"poe_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInterfaceTemplatePoeTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxInterfaceTemplatePoeTypeOptions),
+			},
 			"device_type_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -56,7 +106,7 @@ func resourceNetboxInterfaceTemplate() *schema.Resource {
 				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
 				ForceNew:     true,
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -64,23 +114,11 @@ func resourceNetboxInterfaceTemplate() *schema.Resource {
 }
 
 func resourceNetboxInterfaceTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	label := d.Get("label").(string)
-	interfaceType := d.Get("type").(string)
-	mgmtOnly := d.Get("mgmt_only").(bool)
-
-	data := models.WritableInterfaceTemplate{
-		Name:        &name,
-		Description: description,
-		Label:       label,
-		Type:        &interfaceType,
-		MgmtOnly:    mgmtOnly,
-	}
+	data := getInterfaceTemplateData(d)
 
 	if deviceTypeID, ok := d.Get("device_type_id").(int); ok && deviceTypeID != 0 {
 		data.DeviceType = int64ToPtr(int64(deviceTypeID))
@@ -88,46 +126,88 @@ func resourceNetboxInterfaceTemplateCreate(ctx context.Context, d *schema.Resour
 	if moduleTypeID, ok := d.Get("module_type_id").(int); ok && moduleTypeID != 0 {
 		data.ModuleType = int64ToPtr(int64(moduleTypeID))
 	}
-	params := dcim.NewDcimInterfaceTemplatesCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimInterfaceTemplatesCreate(params, nil)
+	res, err := apiRequest(api, http.MethodPost, "/dcim/interface-templates/", nil, data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
 
 	return diags
 }
 
+// getInterfaceTemplateData returns the request body with the attributes that
+// can be updated.
+func getInterfaceTemplateData(d *schema.ResourceData) *writableInterfaceTemplate {
+	name := d.Get("name").(string)
+	interfaceType := d.Get("type").(string)
+
+	var poeMode, poeType *string
+	if value, ok := d.GetOk("poe_mode"); ok {
+		poeMode = strToPtr(value.(string))
+	}
+	if value, ok := d.GetOk("poe_type"); ok {
+		poeType = strToPtr(value.(string))
+	}
+
+	return &writableInterfaceTemplate{
+		WritableInterfaceTemplate: models.WritableInterfaceTemplate{
+			Name:        &name,
+			Description: d.Get("description").(string),
+			Label:       d.Get("label").(string),
+			Type:        &interfaceType,
+		},
+		Enabled:  d.Get("enabled").(bool),
+		MgmtOnly: d.Get("mgmt_only").(bool),
+		PoeMode:  poeMode,
+		PoeType:  poeType,
+	}
+}
+
 func resourceNetboxInterfaceTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimInterfaceTemplatesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimInterfaceTemplatesRead(params, nil)
+	res, err := apiRequest(api, http.MethodGet, "/dcim/interface-templates/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimInterfaceTemplatesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return diag.FromErr(err)
 	}
 
-	tmpl := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var tmpl interfaceTemplate
+	if err := json.Unmarshal(payload, &tmpl); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.Set("name", tmpl.Name)
 	d.Set("description", tmpl.Description)
 	d.Set("label", tmpl.Label)
 	d.Set("type", tmpl.Type.Value)
 	d.Set("mgmt_only", tmpl.MgmtOnly)
+	d.Set("enabled", tmpl.Enabled)
+
+	if tmpl.PoeMode != nil {
+		d.Set("poe_mode", tmpl.PoeMode.Value)
+	} else {
+		d.Set("poe_mode", nil)
+	}
+	if tmpl.PoeType != nil {
+		d.Set("poe_type", tmpl.PoeType.Value)
+	} else {
+		d.Set("poe_type", nil)
+	}
 
 	if tmpl.DeviceType != nil {
 		d.Set("device_type_id", tmpl.DeviceType.ID)
@@ -136,29 +216,16 @@ func resourceNetboxInterfaceTemplateRead(ctx context.Context, d *schema.Resource
 		d.Set("module_type_id", tmpl.ModuleType.ID)
 	}
 
+	setObjectMetadata(d, &tmpl.InterfaceTemplate)
 	return diags
 }
 
 func resourceNetboxInterfaceTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-
-	name := d.Get("name").(string)
-	description := d.Get("description").(string)
-	label := d.Get("label").(string)
-	interfaceType := d.Get("type").(string)
-	mgmtOnly := d.Get("mgmt_only").(bool)
-
-	data := models.WritableInterfaceTemplate{
-		Name:        &name,
-		Description: description,
-		Label:       label,
-		Type:        &interfaceType,
-		MgmtOnly:    mgmtOnly,
-	}
+	data := getInterfaceTemplateData(d)
 
 	if d.HasChange("device_type_id") {
 		deviceTypeID := int64(d.Get("device_type_id").(int))
@@ -170,8 +237,12 @@ func resourceNetboxInterfaceTemplateUpdate(ctx context.Context, d *schema.Resour
 		data.ModuleType = &moduleTypeID
 	}
 
-	params := dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Dcim.DcimInterfaceTemplatesPartialUpdate(params, nil)
+	request, err := clearEmptyStrings(d, data, "description", "label")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = apiRequest(api, http.MethodPatch, "/dcim/interface-templates/"+d.Id()+"/", nil, request)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -180,7 +251,7 @@ func resourceNetboxInterfaceTemplateUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNetboxInterfaceTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id)
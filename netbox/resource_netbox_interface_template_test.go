@@ -1,14 +1,16 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxInterfaceTemplate_basic(t *testing.T) {
@@ -122,6 +124,89 @@ resource "netbox_interface_template" "test" {
 	})
 }
 
+func TestAccNetboxInterfaceTemplate_poe(t *testing.T) {
+	testSlug := "interface_template_poe"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+	name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+	model = "%[1]s"
+	slug = "%[1]s"
+	manufacturer_id = netbox_manufacturer.test.id
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_interface_template" "test" {
+	name = "%[1]s"
+	device_type_id = netbox_device_type.test.id
+	type = "1000base-t"
+	mgmt_only = true
+	enabled = false
+	poe_mode = "pse"
+	poe_type = "type2-ieee802.3at"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "mgmt_only", "true"),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "poe_mode", "pse"),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "poe_type", "type2-ieee802.3at"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_interface_template" "test" {
+	name = "%[1]s"
+	device_type_id = netbox_device_type.test.id
+	type = "1000base-t"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "mgmt_only", "false"),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "poe_mode", ""),
+					resource.TestCheckResourceAttr("netbox_interface_template.test", "poe_type", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_interface_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGetInterfaceTemplateData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxInterfaceTemplate().Schema, map[string]interface{}{
+		"name":           "eth0",
+		"type":           "1000base-t",
+		"device_type_id": 1,
+		"enabled":        false,
+	})
+
+	body, err := json.Marshal(getInterfaceTemplateData(d))
+	assert.NoError(t, err)
+
+	// False and empty values are sent, so that they are unset in Netbox.
+	var data map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &data))
+	assert.Equal(t, map[string]interface{}{
+		"name":      "eth0",
+		"type":      "1000base-t",
+		"enabled":   false,
+		"mgmt_only": false,
+		"poe_mode":  nil,
+		"poe_type":  nil,
+	}, data)
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_interface_template", &resource.Sweeper{
 		Name:         "netbox_interface_template",
@@ -131,7 +216,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimInterfaceTemplatesListParams()
 			res, err := api.Dcim.DcimInterfaceTemplatesList(params, nil)
 			if err != nil {
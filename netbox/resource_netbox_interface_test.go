@@ -2,11 +2,11 @@ package netbox
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -161,6 +161,38 @@ func TestAccNetboxInterface_opts(t *testing.T) {
 	})
 }
 
+func testAccNetboxInterfaceVlansUnset(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_interface" "test1" {
+  name = "%[1]s_1"
+  virtual_machine_id = netbox_virtual_machine.test.id
+}
+
+resource "netbox_interface" "test2" {
+  name = "%[1]s_2"
+  mode = "access"
+  untagged_vlan = netbox_vlan.test1.id
+  virtual_machine_id = netbox_virtual_machine.test.id
+}
+
+resource "netbox_interface" "test3" {
+  name = "%[1]s_3"
+  mode = "tagged-all"
+  tagged_vlans = [netbox_vlan.test1.id, netbox_vlan.test2.id]
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName)
+}
+
+func testAccNetboxInterfaceVlansInvalidMode(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_interface" "invalid" {
+  name = "%[1]s_invalid"
+  mode = "access"
+  tagged_vlans = [netbox_vlan.test2.id]
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName)
+}
+
 func TestAccNetboxInterface_vlans(t *testing.T) {
 	testSlug := "iface_vlan"
 	testName := testAccGetTestName(testSlug)
@@ -197,13 +229,27 @@ func TestAccNetboxInterface_vlans(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: setUp + testAccNetboxInterfaceVlansUnset(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_interface.test1", "mode", ""),
+					resource.TestCheckResourceAttr("netbox_interface.test1", "untagged_vlan", "0"),
+					resource.TestCheckResourceAttr("netbox_interface.test2", "mode", "access"),
+					resource.TestCheckResourceAttrPair("netbox_interface.test2", "untagged_vlan", "netbox_vlan.test1", "id"),
+					resource.TestCheckResourceAttr("netbox_interface.test2", "tagged_vlans.#", "0"),
+				),
+			},
+			{
+				Config:      setUp + testAccNetboxInterfaceVlansUnset(testName) + testAccNetboxInterfaceVlansInvalidMode(testName),
+				ExpectError: regexp.MustCompile("tagged_vlans requires mode to be tagged or tagged-all"),
+			},
 		},
 	})
 }
 
 func testAccCheckInterfaceDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each interface
 	// is destroyed
@@ -243,7 +289,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := virtualization.NewVirtualizationInterfacesListParams()
 			res, err := api.Virtualization.VirtualizationInterfacesList(params, nil)
 			if err != nil {
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +20,7 @@ func resourceNetboxInventoryItem() *schema.Resource {
 
 > Inventory items represent hardware components installed within a device, such as a power supply or CPU or line card. They are intended to be used primarily for inventory purposes.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -87,7 +86,7 @@ func resourceNetboxInventoryItem() *schema.Resource {
 			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -95,7 +94,7 @@ func resourceNetboxInventoryItem() *schema.Resource {
 }
 
 func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableInventoryItem{
 		Device:       int64ToPtr(int64(d.Get("device_id").(int))),
 		Name:         strToPtr(d.Get("name").(string)),
@@ -122,7 +121,7 @@ func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) er
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimInventoryItemsCreateParams().WithData(&data)
@@ -138,7 +137,7 @@ func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimInventoryItemsReadParams().WithID(id)
 
@@ -188,17 +187,18 @@ func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("component_type", item.ComponentType)
 	d.Set("component_id", item.ComponentID)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -228,12 +228,12 @@ func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) er
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimInventoryItemsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimInventoryItemsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimInventoryItemsPartialUpdate(params, nil, withClearedStrings(d, "description", "label", "serial"))
 	if err != nil {
 		return err
 	}
@@ -242,7 +242,7 @@ func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) er
 }
 
 func resourceNetboxInventoryItemDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimInventoryItemsDeleteParams().WithID(id)
@@ -0,0 +1,211 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxInventoryItemTemplateComponentTypeOptions = []string{
+	"dcim.consoleporttemplate",
+	"dcim.consoleserverporttemplate",
+	"dcim.frontporttemplate",
+	"dcim.interfacetemplate",
+	"dcim.poweroutlettemplate",
+	"dcim.powerporttemplate",
+	"dcim.rearporttemplate",
+}
+
+func resourceNetboxInventoryItemTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxInventoryItemTemplateCreate,
+		ReadContext:   resourceNetboxInventoryItemTemplateRead,
+		UpdateContext: resourceNetboxInventoryItemTemplateUpdate,
+		DeleteContext: resourceNetboxInventoryItemTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitemtemplate/):
+
+> A template for an inventory item that will be automatically created when instantiating a new device. All attributes of this object will be copied to the new inventory item, including the associations with a parent item and assigned component, if any.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the parent inventory item template.",
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"part_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxInventoryItemTemplateComponentTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxInventoryItemTemplateComponentTypeOptions),
+			},
+			"component_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"component_type"},
+				Description:  "The ID of the component template of the same device type that the item is associated with.",
+			},
+			"device_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxInventoryItemTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritableInventoryItemTemplate{
+		Name:         strToPtr(d.Get("name").(string)),
+		Description:  d.Get("description").(string),
+		Label:        d.Get("label").(string),
+		Parent:       getOptionalInt(d, "parent_id"),
+		Role:         getOptionalInt(d, "role_id"),
+		Manufacturer: getOptionalInt(d, "manufacturer_id"),
+		PartID:       d.Get("part_id").(string),
+		DeviceType:   int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	if componentType := getOptionalStr(d, "component_type", false); componentType != "" {
+		data.ComponentType = &componentType
+		data.ComponentID = getOptionalInt(d, "component_id")
+	}
+
+	params := dcim.NewDcimInventoryItemTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimInventoryItemTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxInventoryItemTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimInventoryItemTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimInventoryItemTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInventoryItemTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+	d.Set("parent_id", tmpl.Parent)
+
+	if tmpl.Role != nil {
+		d.Set("role_id", tmpl.Role.ID)
+	} else {
+		d.Set("role_id", nil)
+	}
+
+	if tmpl.Manufacturer != nil {
+		d.Set("manufacturer_id", tmpl.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+
+	d.Set("part_id", tmpl.PartID)
+	d.Set("component_type", tmpl.ComponentType)
+	d.Set("component_id", tmpl.ComponentID)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxInventoryItemTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableInventoryItemTemplate{
+		Name:         strToPtr(d.Get("name").(string)),
+		Description:  d.Get("description").(string),
+		Label:        d.Get("label").(string),
+		Parent:       getOptionalInt(d, "parent_id"),
+		Role:         getOptionalInt(d, "role_id"),
+		Manufacturer: getOptionalInt(d, "manufacturer_id"),
+		PartID:       d.Get("part_id").(string),
+		DeviceType:   int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	if componentType := getOptionalStr(d, "component_type", false); componentType != "" {
+		data.ComponentType = &componentType
+		data.ComponentID = getOptionalInt(d, "component_id")
+	}
+
+	params := dcim.NewDcimInventoryItemTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimInventoryItemTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxInventoryItemTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxInventoryItemTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimInventoryItemTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimInventoryItemTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimInventoryItemTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,11 +26,12 @@ func resourceNetboxIPAddress() *schema.Resource {
 >
 > Like a prefix, an IP address can optionally be assigned to a VRF (otherwise, it will appear in the "global" table). IP addresses are automatically arranged under parent prefixes within their respective VRFs according to the IP hierarchy.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"ip_address": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsCIDR,
+				DiffSuppressFunc: diffSuppressIPAddress,
 			},
 			"interface_id": {
 				Type:         schema.TypeInt,
@@ -59,10 +59,12 @@ func resourceNetboxIPAddress() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"vrf": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"status": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -109,7 +111,7 @@ func resourceNetboxIPAddress() *schema.Resource {
 				},
 			},
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -117,11 +119,11 @@ func resourceNetboxIPAddress() *schema.Resource {
 }
 
 func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableIPAddress{}
 
-	data.Address = strToPtr(d.Get("ip_address").(string))
+	data.Address = strToPtr(normalizeIPAddress(d.Get("ip_address").(string)))
 	data.Status = d.Get("status").(string)
 
 	data.Description = getOptionalStr(d, "description", false)
@@ -156,7 +158,7 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	params := ipam.NewIpamIPAddressesCreateParams().WithData(&data)
@@ -172,7 +174,7 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
@@ -216,12 +218,14 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("vrf_id", nil)
 	}
+	d.Set("vrf", getRelatedObject(ipAddress.Vrf))
 
 	if ipAddress.Tenant != nil {
 		d.Set("tenant_id", ipAddress.Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(ipAddress.Tenant))
 
 	if ipAddress.DNSName != "" {
 		d.Set("dns_name", ipAddress.DNSName)
@@ -260,21 +264,23 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("ip_address", ipAddress.Address)
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
-	d.Set(tagsKey, getTagListFromNestedTagList(ipAddress.Tags))
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, ipAddress.Tags))
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPAddress{}
 
-	data.Address = strToPtr(d.Get("ip_address").(string))
+	data.Address = strToPtr(normalizeIPAddress(d.Get("ip_address").(string)))
 	data.Status = d.Get("status").(string)
 
 	data.Description = getOptionalStr(d, "description", true)
@@ -308,12 +314,12 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
 	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
+	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil, withClearedStrings(d, "description", "dns_name"))
 	if err != nil {
 		return err
 	}
@@ -322,7 +328,7 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxIPAddressDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
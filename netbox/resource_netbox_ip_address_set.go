@@ -0,0 +1,342 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxIPAddressSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIPAddressSetCreate,
+		Read:   resourceNetboxIPAddressSetRead,
+		Update: resourceNetboxIPAddressSetUpdate,
+		Delete: resourceNetboxIPAddressSetDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):Manages many IP addresses with common attributes as a single resource. The addresses are created, updated and deleted with the bulk endpoints of the Netbox API, in batches of ` + "`batch_size`" + ` addresses, so thousands of addresses, e.g. loopbacks, do not need thousands of resources and requests.
+
+The addresses are identified by their address, so changing the address of an element deletes the old address and creates a new one. Import an existing set of addresses by their comma separated IDs, e.g. ` + "`12,13,14`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "active",
+							ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressStatusOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxIPAddressStatusOptions),
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
+						},
+						"dns_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Description: "The IP addresses of the set. Every address may only be listed once.",
+			},
+			"vrf_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The VRF of all addresses.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The tenant of all addresses.",
+			},
+			tagsKey: tagsSchema,
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The maximum number of addresses sent to Netbox in a single request.",
+			},
+			"ip_address_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the IP addresses, by address.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				ids := make(map[string]interface{})
+				if strings.Contains(d.Id(), "=") {
+					// All IP addresses that match the filters form the set
+					api, ok := m.(*providerState)
+					if !ok {
+						return nil, fmt.Errorf("%s can not be imported without a connection to Netbox", d.Id())
+					}
+					objectIDs, err := getIDsByFilter(api, importPaths["netbox_ip_address_set"], d.Id())
+					if err != nil {
+						return nil, err
+					}
+					if len(objectIDs) == 0 {
+						return nil, fmt.Errorf("%w matching %s", errNoObjectFound, d.Id())
+					}
+					for _, objectID := range objectIDs {
+						ids[strconv.FormatInt(objectID, 10)] = int(objectID)
+					}
+				} else {
+					for _, idString := range strings.Split(d.Id(), ",") {
+						objectID, err := strconv.Atoi(strings.TrimSpace(idString))
+						if err != nil {
+							return nil, fmt.Errorf("expected comma separated IDs of IP addresses or filters, got %q", d.Id())
+						}
+						ids[strconv.Itoa(objectID)] = objectID
+					}
+				}
+				d.Set("ip_address_ids", ids)
+				d.Set("batch_size", 100)
+				d.SetId(id.UniqueId())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// getIPAddressSetObjects returns the API objects of the addresses of the set,
+// by normalized address.
+func getIPAddressSetObjects(api *providerState, d *schema.ResourceData) (map[string]map[string]interface{}, error) {
+	tags, diags := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+
+	var vrf, tenant interface{}
+	if vrfID := getOptionalInt(d, "vrf_id"); vrfID != nil {
+		vrf = *vrfID
+	}
+	if tenantID := getOptionalInt(d, "tenant_id"); tenantID != nil {
+		tenant = *tenantID
+	}
+
+	objects := make(map[string]map[string]interface{})
+	for _, element := range d.Get("ip_address").(*schema.Set).List() {
+		ipAddress := element.(map[string]interface{})
+		address := normalizeIPAddress(ipAddress["address"].(string))
+		if _, ok := objects[address]; ok {
+			return nil, fmt.Errorf("the address %s is listed more than once", address)
+		}
+
+		var role interface{}
+		if value := ipAddress["role"].(string); value != "" {
+			role = value
+		}
+		objects[address] = map[string]interface{}{
+			"address":     address,
+			"status":      ipAddress["status"].(string),
+			"role":        role,
+			"dns_name":    ipAddress["dns_name"].(string),
+			"description": ipAddress["description"].(string),
+			"vrf":         vrf,
+			"tenant":      tenant,
+			"tags":        tags,
+		}
+	}
+	return objects, nil
+}
+
+// getIPAddressSetIDs returns the IDs of the addresses of the set in the
+// state, by address.
+func getIPAddressSetIDs(d *schema.ResourceData) map[string]int {
+	ids := make(map[string]int)
+	for address, objectID := range d.Get("ip_address_ids").(map[string]interface{}) {
+		ids[address] = objectID.(int)
+	}
+	return ids
+}
+
+// createIPAddressSetObjects creates the objects in bulk and adds their IDs to
+// ids.
+func createIPAddressSetObjects(api *providerState, objects []map[string]interface{}, batchSize int, ids map[string]int) error {
+	results, err := apiBulkRequest(api, http.MethodPost, "/ipam/ip-addresses/", objects, batchSize)
+	for _, result := range results {
+		object, _ := result.(map[string]interface{})
+		address, _ := object["address"].(string)
+		objectID, _ := object["id"].(json.Number).Int64()
+		ids[normalizeIPAddress(address)] = int(objectID)
+	}
+	return err
+}
+
+func resourceNetboxIPAddressSetCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	objects, err := getIPAddressSetObjects(api, d)
+	if err != nil {
+		return err
+	}
+	var create []map[string]interface{}
+	for _, object := range objects {
+		create = append(create, object)
+	}
+
+	ids := make(map[string]int)
+	err = createIPAddressSetObjects(api, create, d.Get("batch_size").(int), ids)
+	d.SetId(id.UniqueId())
+	d.Set("ip_address_ids", ids)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIPAddressSetRead(d, m)
+}
+
+func resourceNetboxIPAddressSetRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var objectIDs []string
+	for _, objectID := range getIPAddressSetIDs(d) {
+		objectIDs = append(objectIDs, strconv.Itoa(objectID))
+	}
+
+	batchSize := d.Get("batch_size").(int)
+	var ipAddresses []*models.IPAddress
+	for start := 0; start < len(objectIDs); start += batchSize {
+		end := min(start+batchSize, len(objectIDs))
+		params := ipam.NewIpamIPAddressesListParams()
+		params.Limit = int64ToPtr(int64(end - start))
+		res, err := api.Ipam.IpamIPAddressesList(params, nil, withQueryParams(url.Values{"id": objectIDs[start:end]}))
+		if err != nil {
+			return err
+		}
+		ipAddresses = append(ipAddresses, res.GetPayload().Results...)
+	}
+
+	if len(ipAddresses) == 0 {
+		// All addresses were deleted outside of Terraform.
+		d.SetId("")
+		return nil
+	}
+
+	ids := make(map[string]int)
+	var elements []map[string]interface{}
+	for _, ipAddress := range ipAddresses {
+		address := normalizeIPAddress(*ipAddress.Address)
+		ids[address] = int(ipAddress.ID)
+
+		role := ""
+		if ipAddress.Role != nil {
+			role = *ipAddress.Role.Value
+		}
+		elements = append(elements, map[string]interface{}{
+			"address":     address,
+			"status":      *ipAddress.Status.Value,
+			"role":        role,
+			"dns_name":    ipAddress.DNSName,
+			"description": ipAddress.Description,
+		})
+	}
+	d.Set("ip_address_ids", ids)
+	d.Set("ip_address", elements)
+
+	// The common attributes are taken from the first address.
+	first := ipAddresses[0]
+	if first.Vrf != nil {
+		d.Set("vrf_id", first.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+	if first.Tenant != nil {
+		d.Set("tenant_id", first.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, first.Tags))
+
+	return nil
+}
+
+func resourceNetboxIPAddressSetUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	batchSize := d.Get("batch_size").(int)
+
+	objects, err := getIPAddressSetObjects(api, d)
+	if err != nil {
+		return err
+	}
+	ids := getIPAddressSetIDs(d)
+
+	var remove []map[string]interface{}
+	for address, objectID := range ids {
+		if _, ok := objects[address]; !ok {
+			remove = append(remove, map[string]interface{}{"id": objectID})
+		}
+	}
+	if _, err := apiBulkRequest(api, http.MethodDelete, "/ipam/ip-addresses/", remove, batchSize); err != nil {
+		return err
+	}
+	for _, object := range remove {
+		for address, objectID := range ids {
+			if objectID == object["id"] {
+				delete(ids, address)
+			}
+		}
+	}
+
+	var update, create []map[string]interface{}
+	for address, object := range objects {
+		if objectID, ok := ids[address]; ok {
+			object["id"] = objectID
+			update = append(update, object)
+		} else {
+			create = append(create, object)
+		}
+	}
+	if _, err := apiBulkRequest(api, http.MethodPatch, "/ipam/ip-addresses/", update, batchSize); err != nil {
+		d.Set("ip_address_ids", ids)
+		return err
+	}
+
+	err = createIPAddressSetObjects(api, create, batchSize, ids)
+	d.Set("ip_address_ids", ids)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIPAddressSetRead(d, m)
+}
+
+func resourceNetboxIPAddressSetDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	var remove []map[string]interface{}
+	for _, objectID := range getIPAddressSetIDs(d) {
+		remove = append(remove, map[string]interface{}{"id": objectID})
+	}
+	_, err := apiBulkRequest(api, http.MethodDelete, "/ipam/ip-addresses/", remove, d.Get("batch_size").(int))
+	return err
+}
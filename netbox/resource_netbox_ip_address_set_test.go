@@ -0,0 +1,135 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxIPAddressSet_basic(t *testing.T) {
+	testSlug := "ipaddrset_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ip_address_set" "test" {
+  batch_size = 2
+  tags       = [netbox_tag.test.name]
+
+  ip_address {
+    address = "1.1.5.1/32"
+  }
+  ip_address {
+    address  = "1.1.5.2/32"
+    dns_name = "b.example.com"
+  }
+  ip_address {
+    address     = "1.1.5.3/32"
+    status      = "reserved"
+    description = "%[1]s"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address_set.test", "ip_address.#", "3"),
+					resource.TestCheckResourceAttr("netbox_ip_address_set.test", "ip_address_ids.%", "3"),
+					resource.TestCheckResourceAttrSet("netbox_ip_address_set.test", "ip_address_ids.1.1.5.1/32"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_ip_address_set.test", "ip_address.*", map[string]string{
+						"address":  "1.1.5.2/32",
+						"status":   "active",
+						"dns_name": "b.example.com",
+					}),
+					resource.TestCheckResourceAttr("netbox_ip_address_set.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ip_address_set" "test" {
+  batch_size = 2
+  tags       = [netbox_tag.test.name]
+
+  ip_address {
+    address  = "1.1.5.2/32"
+    dns_name = "c.example.com"
+  }
+  ip_address {
+    address = "1.1.5.4/32"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address_set.test", "ip_address.#", "2"),
+					resource.TestCheckResourceAttr("netbox_ip_address_set.test", "ip_address_ids.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_ip_address_set.test", "ip_address.*", map[string]string{
+						"address":  "1.1.5.2/32",
+						"dns_name": "c.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_ip_address_set.test", "ip_address.*", map[string]string{
+						"address": "1.1.5.4/32",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAPIBulkRequest(t *testing.T) {
+	var batches []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/ipam/ip-addresses/", r.URL.Path)
+		var objects []map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&objects))
+		batches = append(batches, len(objects))
+		if len(batches) == 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"address": ["Duplicate IP address found"]}`))
+			return
+		}
+		for i, object := range objects {
+			object["id"] = len(batches)*10 + i
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(objects)
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	var objects []map[string]interface{}
+	for i := 1; i <= 5; i++ {
+		objects = append(objects, map[string]interface{}{"address": fmt.Sprintf("10.0.0.%d/32", i)})
+	}
+
+	results, err := apiBulkRequest(api, http.MethodPost, "/ipam/ip-addresses/", objects[:4], 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2}, batches)
+	assert.Len(t, results, 4)
+	assert.Equal(t, json.Number("21"), results[3].(map[string]interface{})["id"])
+
+	results, err = apiBulkRequest(api, http.MethodPost, "/ipam/ip-addresses/", objects[4:], 2)
+	assert.Error(t, err)
+	assert.Empty(t, results)
+}
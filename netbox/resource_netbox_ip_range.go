@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,14 +22,16 @@ func resourceNetboxIPRange() *schema.Resource {
 
 > This model represents an arbitrary range of individual IPv4 or IPv6 addresses, inclusive of its starting and ending addresses. For instance, the range 192.0.2.10 to 192.0.2.20 has eleven members. (The total member count is available as the size property on an IPRange instance.) Like prefixes and IP addresses, each IP range may optionally be assigned to a VRF and/or tenant.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"start_address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: diffSuppressIPAddress,
 			},
 			"end_address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: diffSuppressIPAddress,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -43,20 +44,24 @@ func resourceNetboxIPRange() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"role_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"role": relatedObjectSchema,
 			"vrf_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"vrf": relatedObjectSchema,
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -64,11 +69,11 @@ func resourceNetboxIPRange() *schema.Resource {
 }
 
 func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableIPRange{}
 
-	startAddress := d.Get("start_address").(string)
-	endAddress := d.Get("end_address").(string)
+	startAddress := normalizeIPAddress(d.Get("start_address").(string))
+	endAddress := normalizeIPAddress(d.Get("end_address").(string))
 	status := d.Get("status").(string)
 	description := d.Get("description").(string)
 
@@ -79,6 +84,10 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamIPRangesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamIPRangesCreate(params, nil)
 	if err != nil {
@@ -90,7 +99,7 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPRangesReadParams().WithID(id)
 
@@ -122,6 +131,7 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	if res.GetPayload().Vrf != nil {
 		d.Set("vrf_id", res.GetPayload().Vrf.ID)
 	}
+	d.Set("vrf", getRelatedObject(res.GetPayload().Vrf))
 
 	if res.GetPayload().Description != "" {
 		d.Set("description", res.GetPayload().Description)
@@ -130,22 +140,30 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	if res.GetPayload().Tenant != nil {
 		d.Set("tenant_id", res.GetPayload().Tenant.ID)
 	}
+	d.Set("tenant", getRelatedObject(res.GetPayload().Tenant))
 
 	if res.GetPayload().Role != nil {
 		d.Set("role_id", res.GetPayload().Role.ID)
 	}
+	d.Set("role", getRelatedObject(res.GetPayload().Role))
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPRange{}
-	startAddress := d.Get("start_address").(string)
-	endAddress := d.Get("end_address").(string)
+	startAddress := normalizeIPAddress(d.Get("start_address").(string))
+	endAddress := normalizeIPAddress(d.Get("end_address").(string))
 	status := d.Get("status").(string)
 	description := d.Get("description").(string)
 
@@ -169,8 +187,12 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamIPRangesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamIPRangesUpdate(params, nil)
+	_, err := api.Ipam.IpamIPRangesUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -178,7 +200,7 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxIPRangeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamIPRangesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamIPRangesDelete(params, nil)
@@ -1,15 +1,33 @@
 package netbox
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var resourceNetboxLocationStatusOptions = []string{"planned", "staging", "active", "decommissioning", "retired"}
+
+// writableLocation adds the facility, which go-netbox does not know, and
+// always sends the tenant so that it can be unset.
+type writableLocation struct {
+	models.WritableLocation
+	Facility string `json:"facility"`
+	Tenant   *int64 `json:"tenant"`
+}
+
+// location adds the facility, which go-netbox does not know.
+type location struct {
+	models.Location
+	Facility string `json:"facility"`
+}
+
 func resourceNetboxLocation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxLocationCreate,
@@ -23,7 +41,7 @@ func resourceNetboxLocation() *schema.Resource {
 
 Each location must have a name that is unique within its parent site and location, if any.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,7 +50,7 @@ Each location must have a name that is unique within its parent site and locatio
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -42,17 +60,38 @@ Each location must have a name that is unique within its parent site and locatio
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"site": relatedObjectSchema,
 			"parent_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"parent": relatedObjectSchema,
+			"depth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The depth of the location in the hierarchy of its site, `0` for locations without parent.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxLocationStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxLocationStatusOptions),
+			},
+			"facility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The local facility ID or description.",
+			},
 			tagsKey: tagsSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant":        relatedObjectSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -60,7 +99,7 @@ Each location must have a name that is unique within its parent site and locatio
 }
 
 func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableLocation{}
 
@@ -76,6 +115,7 @@ func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	data.Description = getOptionalStr(d, "description", true)
+	data.Status = d.Get("status").(string)
 
 	siteIDValue, ok := d.GetOk("site_id")
 	if ok {
@@ -96,12 +136,16 @@ func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimLocationsCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimLocationsCreate(params, nil)
+	res, err := api.Dcim.DcimLocationsCreate(params, nil, withRequestBody(&writableLocation{
+		WritableLocation: data,
+		Facility:         d.Get("facility").(string),
+		Tenant:           data.Tenant,
+	}))
 	if err != nil {
 		return err
 	}
@@ -112,59 +156,73 @@ func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimLocationsReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimLocationsRead(params, nil)
+	api := m.(*providerState)
 
+	res, err := apiRequest(api, http.MethodGet, "/dcim/locations/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimLocationsReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	location := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var location location
+	if err := json.Unmarshal(payload, &location); err != nil {
+		return err
+	}
 
 	d.Set("name", location.Name)
 	d.Set("slug", location.Slug)
 	d.Set("description", location.Description)
+	d.Set("facility", location.Facility)
+	d.Set("depth", location.Depth)
+
+	if location.Status != nil {
+		d.Set("status", location.Status.Value)
+	} else {
+		d.Set("status", nil)
+	}
 
-	if res.GetPayload().Site != nil {
-		d.Set("site_id", res.GetPayload().Site.ID)
+	if location.Site != nil {
+		d.Set("site_id", location.Site.ID)
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(location.Site))
 
-	if res.GetPayload().Parent != nil {
-		d.Set("parent_id", res.GetPayload().Parent.ID)
+	if location.Parent != nil {
+		d.Set("parent_id", location.Parent.ID)
 	} else {
 		d.Set("parent_id", nil)
 	}
+	d.Set("parent", getRelatedObject(location.Parent))
 
-	if res.GetPayload().Tenant != nil {
-		d.Set("tenant_id", res.GetPayload().Tenant.ID)
+	if location.Tenant != nil {
+		d.Set("tenant_id", location.Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(location.Tenant))
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, location.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, location.Tags))
 
+	setObjectMetadata(d, &location.Location)
 	return nil
 }
 
 func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableLocation{}
@@ -181,6 +239,7 @@ func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	data.Description = getOptionalStr(d, "description", true)
+	data.Status = d.Get("status").(string)
 
 	siteIDValue, ok := d.GetOk("site_id")
 	if ok {
@@ -204,12 +263,16 @@ func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	params := dcim.NewDcimLocationsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimLocationsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimLocationsPartialUpdate(params, nil, withRequestBody(&writableLocation{
+		WritableLocation: data,
+		Facility:         d.Get("facility").(string),
+		Tenant:           data.Tenant,
+	}), withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -218,7 +281,7 @@ func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxLocationDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimLocationsDeleteParams().WithID(id)
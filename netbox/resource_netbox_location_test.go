@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -37,6 +36,8 @@ resource "netbox_location" "test" {
   description = "my-description"
   site_id     = netbox_site.test.id
   tenant_id   = netbox_tenant.test.id
+  status      = "planned"
+  facility    = "Floor 1"
 }
 
 resource "netbox_location" "test-sub" {
@@ -53,6 +54,11 @@ resource "netbox_location" "test-sub" {
 					resource.TestCheckResourceAttrPair("netbox_location.test", "site_id", "netbox_site.test", "id"),
 					resource.TestCheckResourceAttrPair("netbox_location.test", "tenant_id", "netbox_tenant.test", "id"),
 					resource.TestCheckResourceAttrPair("netbox_location.test", "id", "netbox_location.test-sub", "parent_id"),
+					resource.TestCheckResourceAttr("netbox_location.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_location.test", "facility", "Floor 1"),
+					resource.TestCheckResourceAttr("netbox_location.test", "depth", "0"),
+					resource.TestCheckResourceAttr("netbox_location.test-sub", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_location.test-sub", "depth", "1"),
 				),
 			},
 			{
@@ -73,12 +79,14 @@ resource "netbox_location" "test" {
   name = "%[1]s"
   slug = "%[2]s"
   site_id = netbox_site.test_2.id
-  tenant_id = netbox_tenant.test.id
 }`, testName, randomSlug),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("netbox_location.test", "name", testName),
 					resource.TestCheckResourceAttr("netbox_location.test", "slug", randomSlug),
 					resource.TestCheckResourceAttr("netbox_location.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_location.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_location.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_location.test", "facility", ""),
 				),
 			},
 			{
@@ -176,7 +184,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimLocationsListParams()
 			res, err := api.Dcim.DcimLocationsList(params, nil)
 			if err != nil {
@@ -0,0 +1,312 @@
+package netbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// macAddressInterfacePaths are the API paths of the interfaces a MAC address
+// can be assigned to, by content type.
+var macAddressInterfacePaths = map[string]string{
+	"dcim.interface":             "/dcim/interfaces/",
+	"virtualization.vminterface": "/virtualization/interfaces/",
+}
+
+// writableMACAddress is the request body of a MAC address, which go-netbox
+// does not know.
+type writableMACAddress struct {
+	MacAddress         string              `json:"mac_address"`
+	AssignedObjectType *string             `json:"assigned_object_type"`
+	AssignedObjectID   *int64              `json:"assigned_object_id"`
+	Description        string              `json:"description"`
+	Comments           string              `json:"comments"`
+	Tags               []*models.NestedTag `json:"tags"`
+	CustomFields       interface{}         `json:"custom_fields,omitempty"`
+}
+
+// macAddress is a MAC address as returned by Netbox.
+type macAddress struct {
+	ID                 int64               `json:"id"`
+	URL                strfmt.URI          `json:"url"`
+	Display            string              `json:"display"`
+	Created            *strfmt.DateTime    `json:"created"`
+	LastUpdated        *strfmt.DateTime    `json:"last_updated"`
+	MacAddress         string              `json:"mac_address"`
+	AssignedObjectType *string             `json:"assigned_object_type"`
+	AssignedObjectID   *int64              `json:"assigned_object_id"`
+	Description        string              `json:"description"`
+	Comments           string              `json:"comments"`
+	Tags               []*models.NestedTag `json:"tags"`
+	CustomFields       interface{}         `json:"custom_fields"`
+}
+
+// nestedMACAddress is a MAC address as nested in other objects, e.g. the
+// primary MAC address of an interface.
+type nestedMACAddress struct {
+	ID         int64  `json:"id"`
+	MacAddress string `json:"mac_address"`
+}
+
+func resourceNetboxMACAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxMACAddressCreate,
+		Read:   resourceNetboxMACAddressRead,
+		Update: resourceNetboxMACAddressUpdate,
+		Delete: resourceNetboxMACAddressDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/macaddress/):
+
+> A MAC address object in NetBox comprises a single Ethernet link layer address, and represents a MAC address as reported by or assigned to a network interface. MAC addresses can be assigned to device and virtual machine interfaces. A MAC address can be specified as the primary MAC address for a given device or VM interface.
+
+MAC addresses are available in Netbox 4.2 and later.`,
+
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"mac_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     macAddressSchema.ValidateFunc,
+				DiffSuppressFunc: macAddressSchema.DiffSuppressFunc,
+				Description:      macAddressSchema.Description,
+			},
+			"device_interface_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"virtual_machine_interface_id"},
+			},
+			"virtual_machine_interface_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"device_interface_id"},
+			},
+			"primary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the address is set as the primary MAC address of the assigned interface, so `primary_mac_address` of the interface does not need to reference it. Requires `device_interface_id` or `virtual_machine_interface_id`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// getMACAddressAssignment returns the content type and ID of the interface
+// the MAC address is assigned to by the given attribute values.
+func getMACAddressAssignment(deviceInterfaceID, vmInterfaceID interface{}) (string, int64) {
+	if id := deviceInterfaceID.(int); id != 0 {
+		return "dcim.interface", int64(id)
+	}
+	if id := vmInterfaceID.(int); id != 0 {
+		return "virtualization.vminterface", int64(id)
+	}
+	return "", 0
+}
+
+// setInterfacePrimaryMACAddress sets the primary MAC address of the given
+// interface. A nil macAddressID unsets it.
+func setInterfacePrimaryMACAddress(api *providerState, objectType string, interfaceID int64, macAddressID *int64) error {
+	path := macAddressInterfacePaths[objectType] + strconv.FormatInt(interfaceID, 10) + "/"
+	_, err := apiRequest(api, http.MethodPatch, path, nil, map[string]interface{}{"primary_mac_address": macAddressID})
+	return err
+}
+
+// getInterfacePrimaryMACAddress returns the primary MAC address of the given
+// interface, or nil if it has none.
+func getInterfacePrimaryMACAddress(api *providerState, objectType string, interfaceID int64) (*nestedMACAddress, error) {
+	path := macAddressInterfacePaths[objectType] + strconv.FormatInt(interfaceID, 10) + "/"
+	res, err := apiRequest(api, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var iface struct {
+		PrimaryMacAddress *nestedMACAddress `json:"primary_mac_address"`
+	}
+	if err := json.Unmarshal(payload, &iface); err != nil {
+		return nil, err
+	}
+	return iface.PrimaryMacAddress, nil
+}
+
+func getMACAddressData(api *providerState, d *schema.ResourceData) (*writableMACAddress, error) {
+	data := &writableMACAddress{
+		MacAddress:  normalizeMACAddress(d.Get("mac_address").(string)),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	objectType, interfaceID := getMACAddressAssignment(d.Get("device_interface_id"), d.Get("virtual_machine_interface_id"))
+	if interfaceID != 0 {
+		data.AssignedObjectType = &objectType
+		data.AssignedObjectID = &interfaceID
+	} else if d.Get("primary").(bool) {
+		return nil, fmt.Errorf("primary requires device_interface_id or virtual_machine_interface_id")
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
+	return data, nil
+}
+
+func resourceNetboxMACAddressCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getMACAddressData(api, d)
+	if err != nil {
+		return err
+	}
+
+	res, err := apiRequest(api, http.MethodPost, "/dcim/mac-addresses/", nil, data)
+	if err != nil {
+		return err
+	}
+
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
+
+	if d.Get("primary").(bool) {
+		macAddressID, _ := id.Int64()
+		if err := setInterfacePrimaryMACAddress(api, *data.AssignedObjectType, *data.AssignedObjectID, &macAddressID); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxMACAddressRead(d, m)
+}
+
+func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := apiRequest(api, http.MethodGet, "/dcim/mac-addresses/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var mac macAddress
+	if err := json.Unmarshal(payload, &mac); err != nil {
+		return err
+	}
+
+	d.Set("mac_address", mac.MacAddress)
+	d.Set("device_interface_id", nil)
+	d.Set("virtual_machine_interface_id", nil)
+	d.Set("primary", false)
+
+	if mac.AssignedObjectType != nil && mac.AssignedObjectID != nil {
+		switch *mac.AssignedObjectType {
+		case "dcim.interface":
+			d.Set("device_interface_id", mac.AssignedObjectID)
+		case "virtualization.vminterface":
+			d.Set("virtual_machine_interface_id", mac.AssignedObjectID)
+		}
+
+		primary, err := getInterfacePrimaryMACAddress(api, *mac.AssignedObjectType, *mac.AssignedObjectID)
+		if err != nil {
+			return err
+		}
+		d.Set("primary", primary != nil && primary.ID == mac.ID)
+	}
+
+	d.Set("description", mac.Description)
+	d.Set("comments", mac.Comments)
+
+	cf := getCustomFields(api, mac.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, mac.Tags))
+
+	setObjectMetadata(d, &mac)
+	return nil
+}
+
+func resourceNetboxMACAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data, err := getMACAddressData(api, d)
+	if err != nil {
+		return err
+	}
+
+	macAddressID, _ := strconv.ParseInt(d.Id(), 10, 64)
+	oldDeviceInterfaceID, newDeviceInterfaceID := d.GetChange("device_interface_id")
+	oldVMInterfaceID, newVMInterfaceID := d.GetChange("virtual_machine_interface_id")
+	oldType, oldInterfaceID := getMACAddressAssignment(oldDeviceInterfaceID, oldVMInterfaceID)
+	newType, newInterfaceID := getMACAddressAssignment(newDeviceInterfaceID, newVMInterfaceID)
+	oldPrimary, newPrimary := d.GetChange("primary")
+	reassigned := oldType != newType || oldInterfaceID != newInterfaceID
+
+	// Netbox does not unassign the primary MAC address of an interface, so it
+	// is unset first.
+	if oldPrimary.(bool) && oldInterfaceID != 0 && (reassigned || !newPrimary.(bool)) {
+		if err := setInterfacePrimaryMACAddress(api, oldType, oldInterfaceID, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err = apiRequest(api, http.MethodPatch, "/dcim/mac-addresses/"+d.Id()+"/", nil, data)
+	if err != nil {
+		return err
+	}
+
+	if newPrimary.(bool) && (reassigned || !oldPrimary.(bool)) {
+		if err := setInterfacePrimaryMACAddress(api, newType, newInterfaceID, &macAddressID); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxMACAddressRead(d, m)
+}
+
+func resourceNetboxMACAddressDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	_, err := apiRequest(api, http.MethodDelete, "/dcim/mac-addresses/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
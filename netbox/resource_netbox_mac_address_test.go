@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxMACAddressDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name = "eth0"
+  type = "1000base-t"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_cluster_type" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_cluster" "test" {
+  name = "%[1]s"
+  cluster_type_id = netbox_cluster_type.test.id
+}
+
+resource "netbox_virtual_machine" "test" {
+  name = "%[1]s"
+  cluster_id = netbox_cluster.test.id
+}
+
+resource "netbox_interface" "test" {
+  name = "eth0"
+  virtual_machine_id = netbox_virtual_machine.test.id
+}
+`, testName)
+}
+
+func TestAccNetboxMACAddress_basic(t *testing.T) {
+	testSlug := "mac_address"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxMACAddressDependencies(testName) + fmt.Sprintf(`
+resource "netbox_mac_address" "test" {
+  mac_address = "0a-01-02-03-04-05"
+  device_interface_id = netbox_device_interface.test.id
+  primary = true
+  description = "%[1]s"
+  comments = "%[1]s comments"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "mac_address", "0A:01:02:03:04:05"),
+					resource.TestCheckResourceAttrPair("netbox_mac_address.test", "device_interface_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "virtual_machine_interface_id", "0"),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "primary", "true"),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "comments", testName+" comments"),
+				),
+			},
+			{
+				// Refresh the interface to read the primary MAC address set by netbox_mac_address.
+				Config: testAccNetboxMACAddressDependencies(testName) + fmt.Sprintf(`
+resource "netbox_mac_address" "test" {
+  mac_address = "0a-01-02-03-04-05"
+  device_interface_id = netbox_device_interface.test.id
+  primary = true
+  description = "%[1]s"
+  comments = "%[1]s comments"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_interface.test", "primary_mac_address", "netbox_mac_address.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interface.test", "mac_address", "0A:01:02:03:04:05"),
+				),
+			},
+			{
+				Config: testAccNetboxMACAddressDependencies(testName) + `
+resource "netbox_mac_address" "test" {
+  mac_address = "0a:01:02:03:04:05"
+  virtual_machine_interface_id = netbox_interface.test.id
+}
+
+resource "netbox_mac_address" "primary" {
+  mac_address = "0a:01:02:03:04:06"
+  virtual_machine_interface_id = netbox_interface.test.id
+  primary = true
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "device_interface_id", "0"),
+					resource.TestCheckResourceAttrPair("netbox_mac_address.test", "virtual_machine_interface_id", "netbox_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "primary", "false"),
+					resource.TestCheckResourceAttr("netbox_mac_address.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_mac_address.primary", "primary", "true"),
+				),
+			},
+			{
+				ResourceName:      "netbox_mac_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_mac_address", &resource.Sweeper{
+		Name:         "netbox_mac_address",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			results, err := apiList(api, "/dcim/mac-addresses/", nil, 0, 0)
+			if err != nil {
+				return err
+			}
+			for _, result := range results {
+				macAddress, _ := result.(map[string]interface{})
+				description, _ := macAddress["description"].(string)
+				if strings.HasPrefix(description, testPrefix) {
+					id, _ := macAddress["id"].(json.Number)
+					_, err := apiRequest(api, http.MethodDelete, "/dcim/mac-addresses/"+id.String()+"/", nil, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a MAC address")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -3,13 +3,20 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// writableModule adds the create-only component flags go-netbox does not know
+// to the module sent to Netbox.
+type writableModule struct {
+	models.WritableModule
+	ReplicateComponents bool `json:"replicate_components"`
+	AdoptComponents     bool `json:"adopt_components"`
+}
+
 func resourceNetboxModule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxModuleCreate,
@@ -23,7 +30,7 @@ func resourceNetboxModule() *schema.Resource {
 
 Similar to devices, modules are instantiated from module types, and any components associated with the module type are automatically instantiated on the new model. Each module must be installed within a module bay on a device, and each module bay may have only one module installed in it.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -58,17 +65,35 @@ Similar to devices, modules are instantiated from module types, and any componen
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"replicate_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `true`, the components of the module type are created on the device when the module is installed. Only applies when the module is created.",
+			},
+			"adopt_components": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, components that already exist on the device with the same name as a component of the module type are assigned to the module instead of being created again. Only applies when the module is created.",
+			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// Netbox does not return the component flags, so imported
+				// modules get the defaults to avoid a spurious diff
+				d.Set("replicate_components", true)
+				d.Set("adopt_components", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 	}
 }
 
 func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableModule{
 		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
@@ -88,12 +113,16 @@ func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimModulesCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimModulesCreate(params, nil)
+	res, err := api.Dcim.DcimModulesCreate(params, nil, withRequestBody(&writableModule{
+		WritableModule:      data,
+		ReplicateComponents: d.Get("replicate_components").(bool),
+		AdoptComponents:     d.Get("adopt_components").(bool),
+	}))
 	if err != nil {
 		return err
 	}
@@ -104,18 +133,20 @@ func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimModulesReadParams().WithID(id)
 
 	res, err := api.Dcim.DcimModulesRead(params, nil)
 
 	if err != nil {
-		errorcode := err.(*dcim.DcimModulesReadDefault).Code()
-		if errorcode == 404 {
-			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-			d.SetId("")
-			return nil
+		if errresp, ok := err.(*dcim.DcimModulesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
 		}
 		return err
 	}
@@ -151,17 +182,18 @@ func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", module.Description)
 	d.Set("comments", module.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -183,12 +215,12 @@ func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimModulesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimModulesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimModulesPartialUpdate(params, nil, withClearedStrings(d, "comments", "description", "serial"))
 	if err != nil {
 		return err
 	}
@@ -197,13 +229,19 @@ func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxModuleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimModulesDeleteParams().WithID(id)
 
 	_, err := api.Dcim.DcimModulesDelete(params, nil)
 	if err != nil {
+		if errresp, ok := err.(*dcim.DcimModulesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
 		return err
 	}
 	return nil
@@ -0,0 +1,146 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxModuleBayTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxModuleBayTemplateCreate,
+		ReadContext:   resourceNetboxModuleBayTemplateRead,
+		UpdateContext: resourceNetboxModuleBayTemplateUpdate,
+		DeleteContext: resourceNetboxModuleBayTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/modulebaytemplate/):
+
+> A template for a module bay that will be created on all instantiations of the parent device type. Module bays hold installable modules that do not appear within rack elevations or require their own names.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"position": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 30),
+				Description:  "The position of the bay within the device. It replaces `{module}` in the names of the components of the installed module.",
+			},
+			"device_type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxModuleBayTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritableModuleBayTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Position:    d.Get("position").(string),
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	params := dcim.NewDcimModuleBayTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimModuleBayTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxModuleBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxModuleBayTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimModuleBayTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimModuleBayTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimModuleBayTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+	d.Set("position", tmpl.Position)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxModuleBayTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableModuleBayTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Position:    d.Get("position").(string),
+		DeviceType:  int64ToPtr(int64(d.Get("device_type_id").(int))),
+	}
+
+	params := dcim.NewDcimModuleBayTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimModuleBayTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label", "position"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxModuleBayTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxModuleBayTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimModuleBayTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimModuleBayTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimModuleBayTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -127,9 +126,64 @@ resource "netbox_module" "test" {
 	})
 }
 
+func TestAccNetboxModule_adoptComponents(t *testing.T) {
+	testSlug := "module_adopt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckModuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxModuleFullDependencies(testName) + `
+resource "netbox_interface_template" "test" {
+  name = "eth0"
+  type = "1000base-t"
+  module_type_id = netbox_module_type.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name = "eth0"
+  type = "1000base-t"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_module" "test" {
+  device_id = netbox_device.test.id
+  module_bay_id = netbox_device_module_bay.test.id
+  module_type_id = netbox_module_type.test.id
+  status = "active"
+  adopt_components = true
+
+  depends_on = [netbox_interface_template.test, netbox_device_interface.test]
+}
+
+data "netbox_device_interfaces" "test" {
+  filter {
+    name = "module_id"
+    value = netbox_module.test.id
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_module.test", "replicate_components", "true"),
+					resource.TestCheckResourceAttr("netbox_module.test", "adopt_components", "true"),
+					resource.TestCheckResourceAttr("data.netbox_device_interfaces.test", "interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_interfaces.test", "interfaces.0.id", "netbox_device_interface.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "netbox_module.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_components"},
+			},
+		},
+	})
+}
+
 func testAccCheckModuleDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each module
 	// is destroyed
@@ -169,7 +223,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimModulesListParams()
 			res, err := api.Dcim.DcimModulesList(params, nil)
 			if err != nil {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +21,7 @@ func resourceNetboxPermission() *schema.Resource {
 > Object-based permissions enable an administrator to grant users or groups the ability to perform an action on arbitrary subsets of objects in NetBox, rather than all objects of a certain type.
 > For more information, see the [Netbox Object-Based Permissions Docs.](https://docs.netbox.dev/en/stable/administration/permissions/)`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Description: "The name of the permission object.",
@@ -79,14 +78,14 @@ func resourceNetboxPermission() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsJSON,
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 func resourceNetboxPermissionCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableObjectPermission{}
 
 	name := d.Get("name").(string)
@@ -127,7 +126,7 @@ func resourceNetboxPermissionCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxPermissionRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersPermissionsReadParams().WithID(id)
 
@@ -174,11 +173,12 @@ func resourceNetboxPermissionRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("constraints", string(b))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxPermissionUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableObjectPermission{}
 
@@ -209,7 +209,7 @@ func resourceNetboxPermissionUpdate(d *schema.ResourceData, m interface{}) error
 		}
 	}
 	params := users.NewUsersPermissionsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersPermissionsUpdate(params, nil)
+	_, err := api.Users.UsersPermissionsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -217,7 +217,7 @@ func resourceNetboxPermissionUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxPermissionDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersPermissionsDeleteParams().WithID(id)
 	_, err := api.Users.UsersPermissionsDelete(params, nil)
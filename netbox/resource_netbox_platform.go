@@ -1,15 +1,30 @@
 package netbox
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// writablePlatform adds the config template, which go-netbox does not know,
+// and always sends the manufacturer so that it can be unset.
+type writablePlatform struct {
+	models.WritablePlatform
+	Manufacturer   *int64 `json:"manufacturer"`
+	ConfigTemplate *int64 `json:"config_template"`
+}
+
+// platform adds the config template, which go-netbox does not know.
+type platform struct {
+	models.Platform
+	ConfigTemplate *models.NestedConfigTemplate `json:"config_template"`
+}
+
 func resourceNetboxPlatform() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxPlatformCreate,
@@ -21,7 +36,7 @@ func resourceNetboxPlatform() *schema.Resource {
 
 > A platform defines the type of software running on a device or virtual machine. This can be helpful to model when it is necessary to distinguish between different versions or feature sets. Note that two devices of the same type may be assigned different platforms: For example, one Juniper MX240 might run Junos 14 while another runs Junos 15.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,13 +45,21 @@ func resourceNetboxPlatform() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"manufacturer_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The manufacturer the platform is limited to, e.g. for the operating system of a vendor.",
 			},
-		},
+			"config_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The config template used to render the configuration of devices and virtual machines of the platform that have no config template of their own or of their role.",
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -44,7 +67,7 @@ func resourceNetboxPlatform() *schema.Resource {
 }
 
 func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -57,10 +80,12 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 		slug = slugValue.(string)
 	}
 
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
 	data := models.WritablePlatform{
 		Name: &name,
 		Slug: &slug,
-		Tags: []*models.NestedTag{},
+		Tags: tags,
 	}
 
 	manufacturerIDValue, ok := d.GetOk("manufacturer_id")
@@ -68,9 +93,17 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 		data.Manufacturer = int64ToPtr(int64(manufacturerIDValue.(int)))
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimPlatformsCreateParams().WithData(&data)
 
-	res, err := api.Dcim.DcimPlatformsCreate(params, nil)
+	res, err := api.Dcim.DcimPlatformsCreate(params, nil, withRequestBody(&writablePlatform{
+		WritablePlatform: data,
+		Manufacturer:     data.Manufacturer,
+		ConfigTemplate:   getOptionalInt(d, "config_template_id"),
+	}))
 	if err != nil {
 		//return errors.New(getTextFromError(err))
 		return err
@@ -82,36 +115,53 @@ func resourceNetboxPlatformCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPlatformsReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimPlatformsRead(params, nil)
+	api := m.(*providerState)
 
+	res, err := apiRequest(api, http.MethodGet, "/dcim/platforms/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimPlatformsReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	result := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var platform platform
+	if err := json.Unmarshal(payload, &platform); err != nil {
+		return err
+	}
 
-	d.Set("name", result.Name)
-	d.Set("slug", result.Slug)
-	if result.Manufacturer != nil {
-		d.Set("manufacturer_id", result.Manufacturer.ID)
+	d.Set("name", platform.Name)
+	d.Set("slug", platform.Slug)
+	if platform.Manufacturer != nil {
+		d.Set("manufacturer_id", platform.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
 	}
+	if platform.ConfigTemplate != nil {
+		d.Set("config_template_id", platform.ConfigTemplate.ID)
+	} else {
+		d.Set("config_template_id", nil)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, platform.Tags))
+
+	cf := getCustomFields(api, platform.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, &platform.Platform)
 	return nil
 }
 
 func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritablePlatform{}
@@ -129,16 +179,25 @@ func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Slug = &slug
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 
 	manufacturerIDValue, ok := d.GetOk("manufacturer_id")
 	if ok {
 		data.Manufacturer = int64ToPtr(int64(manufacturerIDValue.(int)))
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimPlatformsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimPlatformsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimPlatformsPartialUpdate(params, nil, withRequestBody(&writablePlatform{
+		WritablePlatform: data,
+		Manufacturer:     data.Manufacturer,
+		ConfigTemplate:   getOptionalInt(d, "config_template_id"),
+	}))
 	if err != nil {
 		return err
 	}
@@ -147,7 +206,7 @@ func resourceNetboxPlatformUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPlatformDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimPlatformsDeleteParams().WithID(id)
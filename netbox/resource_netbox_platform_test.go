@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -70,6 +69,65 @@ resource "netbox_platform" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[3]s"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+  slug = "%[2]s"
+}`, testName, randomSlug, testManufacturer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_platform.test", "manufacturer_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxPlatform_configTemplate(t *testing.T) {
+	testSlug := "platform_config_template"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_platform" "test" {
+  name               = "%[1]s"
+  config_template_id = netbox_config_template.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_platform.test", "config_template_id", "netbox_config_template.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_platform.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_config_template" "test" {
+  name          = "%[1]s"
+  template_code = "hostname {{ device.name }}"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_platform.test", "config_template_id", "0"),
+				),
+			},
 		},
 	})
 }
@@ -104,7 +162,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimPlatformsListParams()
 			res, err := api.Dcim.DcimPlatformsList(params, nil)
 			if err != nil {
@@ -0,0 +1,190 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerOutletTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxPowerOutletTemplateCreate,
+		ReadContext:   resourceNetboxPowerOutletTemplateRead,
+		UpdateContext: resourceNetboxPowerOutletTemplateUpdate,
+		DeleteContext: resourceNetboxPowerOutletTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/poweroutlettemplate/):
+
+> A template for a power outlet that will be created on all instantiations of the parent device type. See the power outlet documentation for more detail.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDevicePowerOutletTypeOptions),
+				Description:      buildValidValueDescription(resourceNetboxDevicePowerOutletTypeOptions),
+			},
+			"power_port_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The power port template of the same device or module type that feeds the outlet.",
+			},
+			"feed_leg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"A", "B", "C"}, false),
+				Description:  buildValidValueDescription([]string{"A", "B", "C"}),
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerOutletTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritablePowerOutletTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Type:        d.Get("type").(string),
+		PowerPort:   getOptionalInt(d, "power_port_template_id"),
+		FeedLeg:     d.Get("feed_leg").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimPowerOutletTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimPowerOutletTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerOutletTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxPowerOutletTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimPowerOutletTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerOutletTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerOutletTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+
+	if tmpl.PowerPort != nil {
+		d.Set("power_port_template_id", tmpl.PowerPort.ID)
+	} else {
+		d.Set("power_port_template_id", nil)
+	}
+
+	if tmpl.FeedLeg != nil {
+		d.Set("feed_leg", tmpl.FeedLeg.Value)
+	} else {
+		d.Set("feed_leg", nil)
+	}
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxPowerOutletTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritablePowerOutletTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Description: d.Get("description").(string),
+		Label:       d.Get("label").(string),
+		Type:        d.Get("type").(string),
+		PowerPort:   getOptionalInt(d, "power_port_template_id"),
+		FeedLeg:     d.Get("feed_leg").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimPowerOutletTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimPowerOutletTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxPowerOutletTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxPowerOutletTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerOutletTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerOutletTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerOutletTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
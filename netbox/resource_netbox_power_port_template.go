@@ -0,0 +1,182 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxPowerPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxPowerPortTemplateCreate,
+		ReadContext:   resourceNetboxPowerPortTemplateRead,
+		UpdateContext: resourceNetboxPowerPortTemplateUpdate,
+		DeleteContext: resourceNetboxPowerPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerporttemplate/):
+
+> A template for a power port that will be created on all instantiations of the parent device type. See the power port documentation for more detail.`,
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxDevicePowerPortTypeOptions),
+				Description:      buildValidValueDescription(resourceNetboxDevicePowerPortTypeOptions),
+			},
+			"maximum_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "Maximum power draw in watts.",
+			},
+			"allocated_draw": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+				Description:  "Allocated power draw in watts.",
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxPowerPortTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := models.WritablePowerPortTemplate{
+		Name:          strToPtr(d.Get("name").(string)),
+		Description:   d.Get("description").(string),
+		Label:         d.Get("label").(string),
+		Type:          d.Get("type").(string),
+		MaximumDraw:   getOptionalInt(d, "maximum_draw"),
+		AllocatedDraw: getOptionalInt(d, "allocated_draw"),
+		DeviceType:    getOptionalInt(d, "device_type_id"),
+		ModuleType:    getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimPowerPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxPowerPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxPowerPortTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimPowerPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimPowerPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerPortTemplatesReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("description", tmpl.Description)
+	d.Set("label", tmpl.Label)
+
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+
+	d.Set("maximum_draw", tmpl.MaximumDraw)
+	d.Set("allocated_draw", tmpl.AllocatedDraw)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	setObjectMetadata(d, tmpl)
+	return nil
+}
+
+func resourceNetboxPowerPortTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritablePowerPortTemplate{
+		Name:          strToPtr(d.Get("name").(string)),
+		Description:   d.Get("description").(string),
+		Label:         d.Get("label").(string),
+		Type:          d.Get("type").(string),
+		MaximumDraw:   getOptionalInt(d, "maximum_draw"),
+		AllocatedDraw: getOptionalInt(d, "allocated_draw"),
+		DeviceType:    getOptionalInt(d, "device_type_id"),
+		ModuleType:    getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimPowerPortTemplatesPartialUpdate(params, nil, withClearedStrings(d, "description", "label"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetboxPowerPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxPowerPortTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimPowerPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimPowerPortTemplatesDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,11 +24,12 @@ func resourceNetboxPrefix() *schema.Resource {
 >
 > Prefixes are automatically organized by their parent aggregates. Additionally, each prefix can be assigned to a particular site and virtual routing and forwarding instance (VRF). Each VRF represents a separate IP space or routing table. All prefixes not assigned to a VRF are considered to be in the "global" table.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"prefix": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsCIDR,
+				DiffSuppressFunc: diffSuppressIPAddress,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -53,35 +53,40 @@ func resourceNetboxPrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"vrf": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"site_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"site": relatedObjectSchema,
 			"vlan_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"vlan": relatedObjectSchema,
 			"role_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"role":          relatedObjectSchema,
 			customFieldsKey: customFieldsSchema,
 			tagsKey:         tagsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritablePrefix{}
 
-	prefix := d.Get("prefix").(string)
+	prefix := normalizeIPAddress(d.Get("prefix").(string))
 	status := d.Get("status").(string)
 	description := d.Get("description").(string)
 	isPool := d.Get("is_pool").(bool)
@@ -117,7 +122,7 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
@@ -133,7 +138,7 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamPrefixesReadParams().WithID(id)
 
@@ -165,47 +170,53 @@ func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("vrf_id", nil)
 	}
+	d.Set("vrf", getRelatedObject(res.GetPayload().Vrf))
 
 	if res.GetPayload().Tenant != nil {
 		d.Set("tenant_id", res.GetPayload().Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(res.GetPayload().Tenant))
 
 	if res.GetPayload().Site != nil {
 		d.Set("site_id", res.GetPayload().Site.ID)
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(res.GetPayload().Site))
 
 	if res.GetPayload().Vlan != nil {
 		d.Set("vlan_id", res.GetPayload().Vlan.ID)
 	} else {
 		d.Set("vlan_id", nil)
 	}
+	d.Set("vlan", getRelatedObject(res.GetPayload().Vlan))
 
 	if res.GetPayload().Role != nil {
 		d.Set("role_id", res.GetPayload().Role.ID)
 	} else {
 		d.Set("role_id", nil)
 	}
+	d.Set("role", getRelatedObject(res.GetPayload().Role))
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 	// FIGURE OUT NESTED VRF AND NESTED VLAN (from maybe interfaces?)
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritablePrefix{}
-	prefix := d.Get("prefix").(string)
+	prefix := normalizeIPAddress(d.Get("prefix").(string))
 	status := d.Get("status").(string)
 	isPool := d.Get("is_pool").(bool)
 	markUtilized := d.Get("mark_utilized").(bool)
@@ -243,13 +254,13 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
 	params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamPrefixesUpdate(params, nil)
+	_, err := api.Ipam.IpamPrefixesUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -257,7 +268,7 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPrefixDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamPrefixesDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamPrefixesDelete(params, nil)
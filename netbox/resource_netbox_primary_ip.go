@@ -1,9 +1,11 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,7 +19,7 @@ func resourceNetboxPrimaryIP() *schema.Resource {
 		Update: resourceNetboxPrimaryIPUpdate,
 		Delete: resourceNetboxPrimaryIPDelete,
 
-		Description: `:meta:subcategory:Virtualization:This resource is used to define the primary IP for a given virtual machine. The primary IP is reflected in the Virtual machine Netbox UI, which identifies the Primary IPv4 and IPv6 addresses.`,
+		Description: `:meta:subcategory:Virtualization:This resource is used to define the primary IP for a given virtual machine. The primary IP is reflected in the Virtual machine Netbox UI, which identifies the Primary IPv4 and IPv6 addresses. It is imported by the ID of the virtual machine, followed by a space and ` + "`6`" + ` to import the primary IPv6 address instead of the IPv4 address.`,
 
 		Schema: map[string]*schema.Schema{
 			"virtual_machine_id": {
@@ -36,11 +38,44 @@ func resourceNetboxPrimaryIP() *schema.Resource {
 			},
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNetboxPrimaryIPImport(importPaths["netbox_primary_ip"]),
 		},
 	}
 }
 
+// resourceNetboxPrimaryIPImport returns the importer of the primary IP of a
+// virtual machine or device at the given API path. The ID is the ID of the
+// virtual machine or device or filters that match it, optionally followed by
+// a space and the IP address version, e.g. "123 6" or "name=vm01 6". Without
+// a version, the primary IPv4 address is imported.
+func resourceNetboxPrimaryIPImport(path string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID, version := d.Id(), 4
+		if i := strings.LastIndex(importID, " "); i >= 0 {
+			switch suffix := importID[i+1:]; {
+			case suffix == "4" || suffix == "6":
+				version, _ = strconv.Atoi(suffix)
+				importID = importID[:i]
+			case !strings.Contains(importID, "="):
+				return nil, fmt.Errorf("ip_address_version (%s) must be 4 or 6", suffix)
+			}
+		}
+
+		id, err := importIDByFilter(m, path, importID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			return nil, fmt.Errorf("unexpected format of (%s), expected 'id' or 'id ip_address_version'", d.Id())
+		}
+
+		d.SetId(id)
+		d.Set("ip_address_version", version)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func resourceNetboxPrimaryIPCreate(d *schema.ResourceData, m interface{}) error {
 	d.SetId(strconv.Itoa(d.Get("virtual_machine_id").(int)))
 
@@ -48,7 +83,7 @@ func resourceNetboxPrimaryIPCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxPrimaryIPRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(id)
 
@@ -82,7 +117,7 @@ func resourceNetboxPrimaryIPRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxPrimaryIPUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	virtualMachineID := int64(d.Get("virtual_machine_id").(int))
 	IPAddressID := int64(d.Get("ip_address_id").(int))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func testAccNetboxPrimaryIPFullDependencies(testName string) string {
@@ -122,6 +123,11 @@ resource "netbox_primary_ip" "test_v4" {
 					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "local_context_data", "{\"context_string\":\"context_value\"}"),
 				),
 			},
+			{
+				ResourceName:      "netbox_primary_ip.test_v4",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -165,6 +171,14 @@ resource "netbox_primary_ip" "test_v6" {
 					resource.TestCheckResourceAttr("netbox_virtual_machine.test", "status", "planned"),
 				),
 			},
+			{
+				ResourceName:      "netbox_primary_ip.test_v6",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["netbox_primary_ip.test_v6"].Primary.ID + " 6", nil
+				},
+			},
 		},
 	})
 }
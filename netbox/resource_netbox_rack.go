@@ -1,9 +1,11 @@
 package netbox
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +17,25 @@ var resourceNetboxRackTypeOptions = []string{"2-post-frame", "4-post-frame", "4-
 var resourceNetboxRackWeightUnitOptions = []string{"kg", "g", "lb", "oz"}
 var resourceNetboxRackOuterUnitOptions = []string{"mm", "in"}
 var resourceNetboxRackWidthOptions = []int{10, 19, 21, 23}
+var resourceNetboxRackAirflowOptions = []string{"front-to-rear", "rear-to-front"}
+
+// writableRack adds the fields to the go-netbox model that it does not know.
+type writableRack struct {
+	models.WritableRack
+	RackType     *int64 `json:"rack_type"`
+	FormFactor   string `json:"form_factor"`
+	StartingUnit *int64 `json:"starting_unit,omitempty"`
+	Airflow      string `json:"airflow"`
+}
+
+// rack adds the fields to the go-netbox model that it does not know.
+type rack struct {
+	models.Rack
+	RackType     *rackType             `json:"rack_type"`
+	FormFactor   *models.RackType      `json:"form_factor"`
+	StartingUnit *int64                `json:"starting_unit"`
+	Airflow      *models.DeviceAirflow `json:"airflow"`
+}
 
 func resourceNetboxRack() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +52,7 @@ Rack height is measured in rack units (U); racks are commonly between 42U and 48
 
 Each rack is assigned a name and (optionally) a separate facility ID. This is helpful when leasing space in a data center your organization does not own: The facility will often assign a seemingly arbitrary ID to a rack (for example, "M204.313") whereas internally you refer to is simply as "R113." A unique serial number and asset tag may also be associated with each rack.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -40,6 +61,7 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"site": relatedObjectSchema,
 			"status": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -62,6 +84,7 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"facility_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -70,10 +93,17 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"location": relatedObjectSchema,
 			"role_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"role": relatedObjectSchema,
+			"rack_type_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The rack type of the rack. Netbox copies the physical attributes of the rack type to the rack, so they should match. Requires Netbox 4.1 or later.",
+			},
 			"serial": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -85,10 +115,32 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				ValidateFunc: validation.StringLenBetween(0, 50),
 			},
 			"type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(resourceNetboxRackTypeOptions, false),
+				Description:   buildValidValueDescription(resourceNetboxRackTypeOptions),
+				ConflictsWith: []string{"form_factor"},
+				Deprecated:    "Netbox 4.1 renamed this attribute. Use form_factor instead.",
+			},
+			"form_factor": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(resourceNetboxRackTypeOptions, false),
+				Description:   buildValidValueDescription(resourceNetboxRackTypeOptions),
+				ConflictsWith: []string{"type"},
+			},
+			"starting_unit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePositiveInt16,
+				Description:  "The number of the lowest unit of the rack. Requires Netbox 4.1 or later.",
+			},
+			"airflow": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(resourceNetboxRackTypeOptions, false),
-				Description:  buildValidValueDescription(resourceNetboxRackTypeOptions),
+				ValidateFunc: validation.StringInSlice(resourceNetboxRackAirflowOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxRackAirflowOptions) + ". Requires Netbox 4.1 or later.",
 			},
 			"weight": {
 				Type:     schema.TypeFloat,
@@ -144,7 +196,7 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				Optional: true,
 			},
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -152,7 +204,7 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 }
 
 func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	siteID := int64(d.Get("site_id").(int))
@@ -178,7 +230,7 @@ func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
 	if assetTag := getOptionalStr(d, "asset_tag", false); assetTag != "" {
 		data.AssetTag = &assetTag
 	}
-	data.Type = getOptionalStr(d, "type", false)
+	data.Type = getRackFormFactor(d)
 	data.Weight = getOptionalFloat(d, "weight")
 	data.MaxWeight = getOptionalInt(d, "max_weight")
 	data.WeightUnit = getOptionalStr(d, "weight_unit", false)
@@ -198,41 +250,48 @@ func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
-	params := dcim.NewDcimRacksCreateParams().WithData(&data)
-
-	res, err := api.Dcim.DcimRacksCreate(params, nil)
+	res, err := apiRequest(api, http.MethodPost, "/dcim/racks/", nil, &writableRack{
+		WritableRack: data,
+		RackType:     getOptionalInt(d, "rack_type_id"),
+		FormFactor:   data.Type,
+		StartingUnit: getOptionalInt(d, "starting_unit"),
+		Airflow:      d.Get("airflow").(string),
+	})
 	if err != nil {
 		return err
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
 
 	return resourceNetboxRackRead(d, m)
 }
 
 func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRacksReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimRacksRead(params, nil)
+	api := m.(*providerState)
 
+	res, err := apiRequest(api, http.MethodGet, "/dcim/racks/"+d.Id()+"/", nil, nil)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimRacksReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	rack := res.GetPayload()
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var rack rack
+	if err := json.Unmarshal(payload, &rack); err != nil {
+		return err
+	}
 
 	d.Set("name", rack.Name)
 
@@ -241,6 +300,7 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(rack.Site))
 
 	if rack.Status != nil {
 		d.Set("status", rack.Status.Value)
@@ -261,6 +321,7 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(rack.Tenant))
 
 	d.Set("facility_id", rack.FacilityID)
 
@@ -269,22 +330,51 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("location_id", nil)
 	}
+	d.Set("location", getRelatedObject(rack.Location))
 
 	if rack.Role != nil {
 		d.Set("role_id", rack.Role.ID)
 	} else {
 		d.Set("role_id", nil)
 	}
+	d.Set("role", getRelatedObject(rack.Role))
+
+	if rack.RackType != nil {
+		d.Set("rack_type_id", rack.RackType.ID)
+	} else {
+		d.Set("rack_type_id", nil)
+	}
 
 	d.Set("serial", rack.Serial)
 	d.Set("asset_tag", rack.AssetTag)
 
-	if rack.Type != nil {
-		d.Set("type", rack.Type.Value)
+	// Netbox 4.1 and later return the form factor instead of the type
+	formFactor := rack.FormFactor
+	if formFactor == nil {
+		formFactor = rack.Type
+	}
+	var formFactorValue *string
+	if formFactor != nil {
+		formFactorValue = formFactor.Value
+	}
+	if _, ok := d.GetOk("type"); ok {
+		d.Set("type", formFactorValue)
+		d.Set("form_factor", nil)
 	} else {
+		d.Set("form_factor", formFactorValue)
 		d.Set("type", nil)
 	}
 
+	if rack.StartingUnit != nil {
+		d.Set("starting_unit", rack.StartingUnit)
+	}
+
+	if rack.Airflow != nil && rack.Airflow.Value != nil {
+		d.Set("airflow", rack.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+
 	d.Set("weight", rack.Weight)
 	d.Set("max_weight", rack.MaxWeight)
 
@@ -308,19 +398,18 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", rack.Description)
 	d.Set("comments", rack.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, rack.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, rack.Tags))
 
+	setObjectMetadata(d, &rack.Rack)
 	return nil
 }
 
 func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
-
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	siteID := int64(d.Get("site_id").(int))
@@ -348,7 +437,7 @@ func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
 	if assetTag := getOptionalStr(d, "asset_tag", false); assetTag != "" {
 		data.AssetTag = &assetTag
 	}
-	data.Type = getOptionalStr(d, "type", false)
+	data.Type = getRackFormFactor(d)
 	data.Weight = getOptionalFloat(d, "weight")
 	data.MaxWeight = getOptionalInt(d, "max_weight")
 	data.WeightUnit = getOptionalStr(d, "weight_unit", false)
@@ -368,12 +457,16 @@ func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
-	params := dcim.NewDcimRacksPartialUpdateParams().WithID(id).WithData(&data)
-
-	_, err := api.Dcim.DcimRacksPartialUpdate(params, nil)
+	_, err := apiRequest(api, http.MethodPatch, "/dcim/racks/"+d.Id()+"/", nil, &writableRack{
+		WritableRack: data,
+		RackType:     getOptionalInt(d, "rack_type_id"),
+		FormFactor:   data.Type,
+		StartingUnit: getOptionalInt(d, "starting_unit"),
+		Airflow:      d.Get("airflow").(string),
+	})
 	if err != nil {
 		return err
 	}
@@ -382,7 +475,7 @@ func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRackDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRacksDeleteParams().WithID(id)
@@ -399,3 +492,12 @@ func resourceNetboxRackDelete(d *schema.ResourceData, m interface{}) error {
 	}
 	return nil
 }
+
+// getRackFormFactor returns the form factor of the rack, which is given by
+// the deprecated type attribute in older configurations.
+func getRackFormFactor(d *schema.ResourceData) string {
+	if formFactor := getOptionalStr(d, "form_factor", false); formFactor != "" {
+		return formFactor
+	}
+	return getOptionalStr(d, "type", false)
+}
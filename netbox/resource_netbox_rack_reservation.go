@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,7 +19,7 @@ func resourceNetboxRackReservation() *schema.Resource {
 
 > Users can reserve specific units within a rack for future use. An arbitrary set of units within a rack can be associated with a single reservation, but reservations cannot span multiple racks. A description is required for each reservation, reservations may optionally be associated with a specific tenant.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"rack_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -49,8 +48,9 @@ func resourceNetboxRackReservation() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,19 +58,20 @@ func resourceNetboxRackReservation() *schema.Resource {
 }
 
 func resourceNetboxRackReservationCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
 	params := dcim.NewDcimRackReservationsCreateParams().WithData(
 		&models.WritableRackReservation{
-			Rack:        getOptionalInt(d, "rack_id"),
-			Units:       toInt64PtrList(d.Get("units")),
-			User:        getOptionalInt(d, "user_id"),
-			Description: strToPtr(getOptionalStr(d, "description", false)),
-			Tenant:      getOptionalInt(d, "tenant_id"),
-			Comments:    getOptionalStr(d, "comments", false),
-			Tags:        tags,
+			Rack:         getOptionalInt(d, "rack_id"),
+			Units:        toInt64PtrList(d.Get("units")),
+			User:         getOptionalInt(d, "user_id"),
+			Description:  strToPtr(getOptionalStr(d, "description", false)),
+			Tenant:       getOptionalInt(d, "tenant_id"),
+			Comments:     getOptionalStr(d, "comments", false),
+			CustomFields: getCustomFieldsForAPI(api, d.Get(customFieldsKey)),
+			Tags:         tags,
 		},
 	)
 
@@ -85,7 +86,7 @@ func resourceNetboxRackReservationCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRackReservationsReadParams().WithID(id)
 
@@ -126,12 +127,19 @@ func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) er
 
 	d.Set("comments", rackRes.Comments)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -147,9 +155,13 @@ func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{})
 		Tags:        tags,
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimRackReservationsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimRackReservationsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimRackReservationsPartialUpdate(params, nil, withClearedStrings(d, "comments"))
 	if err != nil {
 		return err
 	}
@@ -158,7 +170,7 @@ func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceNetboxRackReservationDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRackReservationsDeleteParams().WithID(id)
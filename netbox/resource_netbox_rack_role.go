@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxRackRole() *schema.Resource {
@@ -21,7 +19,7 @@ func resourceNetboxRackRole() *schema.Resource {
 
 > Each rack can optionally be assigned a user-defined functional role. For example, you might designate a rack for compute or storage resources, or to house colocated customer devices.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,18 +28,20 @@ func resourceNetboxRackRole() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"color_hex": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateColorHex,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -49,7 +49,7 @@ func resourceNetboxRackRole() *schema.Resource {
 }
 
 func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	slugValue, slugOk := d.GetOk("slug")
@@ -69,11 +69,12 @@ func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
 
 	params := dcim.NewDcimRackRolesCreateParams().WithData(
 		&models.RackRole{
-			Name:        &name,
-			Slug:        &slug,
-			Color:       color,
-			Description: description,
-			Tags:        tags,
+			Name:         &name,
+			Slug:         &slug,
+			Color:        color,
+			Description:  description,
+			CustomFields: getCustomFieldsForAPI(api, d.Get(customFieldsKey)),
+			Tags:         tags,
 		},
 	)
 
@@ -88,7 +89,7 @@ func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRackRolesReadParams().WithID(id)
 
@@ -111,12 +112,19 @@ func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("slug", rackRole.Slug)
 	d.Set("description", rackRole.Description)
 	d.Set("color_hex", rackRole.Color)
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.RackRole{}
@@ -142,9 +150,13 @@ func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimRackRolesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimRackRolesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimRackRolesPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -153,7 +165,7 @@ func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRackRoleDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimRackRolesDeleteParams().WithID(id)
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -62,7 +61,7 @@ resource "netbox_rack" "test" {
   role_id = netbox_rack_role.test.id
   serial = "%[1]sserial"
   asset_tag = "%[1]sasset_tag"
-  type = "4-post-frame"
+  form_factor = "4-post-frame"
   desc_units = true
   outer_width = 10
   outer_depth = 15
@@ -76,6 +75,7 @@ resource "netbox_rack" "test2" {
   status = "reserved"
   width = 19
   u_height = 48
+  type = "2-post-frame"
 }
 `, testName),
 				Check: resource.ComposeTestCheckFunc(
@@ -92,7 +92,8 @@ resource "netbox_rack" "test2" {
 					resource.TestCheckResourceAttrPair("netbox_rack.test", "role_id", "netbox_rack_role.test", "id"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "serial", testName+"serial"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "asset_tag", testName+"asset_tag"),
-					resource.TestCheckResourceAttr("netbox_rack.test", "type", "4-post-frame"),
+					resource.TestCheckResourceAttr("netbox_rack.test", "form_factor", "4-post-frame"),
+					resource.TestCheckResourceAttr("netbox_rack.test", "type", ""),
 					resource.TestCheckResourceAttr("netbox_rack.test", "desc_units", "true"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "outer_width", "10"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "outer_depth", "15"),
@@ -105,6 +106,8 @@ resource "netbox_rack" "test2" {
 					resource.TestCheckResourceAttr("netbox_rack.test2", "status", "reserved"),
 					resource.TestCheckResourceAttr("netbox_rack.test2", "width", "19"),
 					resource.TestCheckResourceAttr("netbox_rack.test2", "u_height", "48"),
+					resource.TestCheckResourceAttr("netbox_rack.test2", "type", "2-post-frame"),
+					resource.TestCheckResourceAttr("netbox_rack.test2", "form_factor", ""),
 				),
 			},
 			{
@@ -130,6 +133,7 @@ resource "netbox_rack" "test" {
 					resource.TestCheckResourceAttr("netbox_rack.test", "serial", ""),
 					resource.TestCheckResourceAttr("netbox_rack.test", "asset_tag", ""),
 					resource.TestCheckResourceAttr("netbox_rack.test", "type", ""),
+					resource.TestCheckResourceAttr("netbox_rack.test", "form_factor", ""),
 					resource.TestCheckResourceAttr("netbox_rack.test", "weight", "0"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "max_weight", "0"),
 					resource.TestCheckResourceAttr("netbox_rack.test", "weight_unit", ""),
@@ -151,9 +155,57 @@ resource "netbox_rack" "test" {
 	})
 }
 
+func TestAccNetboxRack_airflow(t *testing.T) {
+	testSlug := "rack_airflow"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxRackFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 42
+  starting_unit = 10
+  airflow = "rear-to-front"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rack.test", "starting_unit", "10"),
+					resource.TestCheckResourceAttr("netbox_rack.test", "airflow", "rear-to-front"),
+				),
+			},
+			{
+				Config: testAccNetboxRackFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 42
+  starting_unit = 1
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rack.test", "starting_unit", "1"),
+					resource.TestCheckResourceAttr("netbox_rack.test", "airflow", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_rack.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckRackDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each rack
 	// is destroyed
@@ -193,7 +245,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimRacksListParams()
 			res, err := api.Dcim.DcimRacksList(params, nil)
 			if err != nil {
@@ -0,0 +1,340 @@
+package netbox
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// writableRackType is the request body of a rack type, which go-netbox does
+// not know. Optional fields are sent as null when they are not set, so they
+// can be unset.
+type writableRackType struct {
+	Manufacturer  int64               `json:"manufacturer"`
+	Model         string              `json:"model"`
+	Slug          string              `json:"slug"`
+	FormFactor    string              `json:"form_factor"`
+	Width         int64               `json:"width"`
+	UHeight       int64               `json:"u_height"`
+	StartingUnit  int64               `json:"starting_unit"`
+	DescUnits     bool                `json:"desc_units"`
+	OuterWidth    *int64              `json:"outer_width"`
+	OuterDepth    *int64              `json:"outer_depth"`
+	OuterUnit     *string             `json:"outer_unit"`
+	Weight        *float64            `json:"weight"`
+	MaxWeight     *int64              `json:"max_weight"`
+	WeightUnit    *string             `json:"weight_unit"`
+	MountingDepth *int64              `json:"mounting_depth"`
+	Description   string              `json:"description"`
+	Comments      string              `json:"comments"`
+	Tags          []*models.NestedTag `json:"tags"`
+	CustomFields  interface{}         `json:"custom_fields,omitempty"`
+}
+
+// rackType is a rack type as returned by Netbox.
+type rackType struct {
+	ID            int64                      `json:"id"`
+	URL           strfmt.URI                 `json:"url"`
+	Display       string                     `json:"display"`
+	Created       *strfmt.DateTime           `json:"created"`
+	LastUpdated   *strfmt.DateTime           `json:"last_updated"`
+	Manufacturer  *models.NestedManufacturer `json:"manufacturer"`
+	Model         string                     `json:"model"`
+	Slug          string                     `json:"slug"`
+	FormFactor    *models.RackType           `json:"form_factor"`
+	Width         *models.RackWidth          `json:"width"`
+	UHeight       int64                      `json:"u_height"`
+	StartingUnit  int64                      `json:"starting_unit"`
+	DescUnits     bool                       `json:"desc_units"`
+	OuterWidth    *int64                     `json:"outer_width"`
+	OuterDepth    *int64                     `json:"outer_depth"`
+	OuterUnit     *models.RackOuterUnit      `json:"outer_unit"`
+	Weight        *float64                   `json:"weight"`
+	MaxWeight     *int64                     `json:"max_weight"`
+	WeightUnit    *models.RackWeightUnit     `json:"weight_unit"`
+	MountingDepth *int64                     `json:"mounting_depth"`
+	Description   string                     `json:"description"`
+	Comments      string                     `json:"comments"`
+	Tags          []*models.NestedTag        `json:"tags"`
+	CustomFields  interface{}                `json:"custom_fields"`
+}
+
+func resourceNetboxRackType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRackTypeCreate,
+		Read:   resourceNetboxRackTypeRead,
+		Update: resourceNetboxRackTypeUpdate,
+		Delete: resourceNetboxRackTypeDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/racktype/):
+
+> A rack type defines the physical characteristics of a particular model of rack.
+
+Rack types are available in Netbox 4.1 and later.`,
+
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"model": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSlug,
+			},
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"form_factor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxRackTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxRackTypeOptions),
+			},
+			"width": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxRackWidthOptions),
+				Description:  "Valid values are `10`, `19`, `21` and `23`",
+			},
+			"u_height": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"starting_unit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validatePositiveInt16,
+				Description:  "The number of the lowest unit of the rack.",
+			},
+			"desc_units": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If rack units are descending",
+				Default:     false,
+			},
+			"outer_width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt16,
+			},
+			"outer_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt16,
+			},
+			"outer_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"outer_width", "outer_depth"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxRackOuterUnitOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxRackOuterUnitOptions),
+			},
+			"weight": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"max_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt32,
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"weight", "max_weight"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxRackWeightUnitOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxRackWeightUnitOptions),
+			},
+			"mounting_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt16,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// getRackTypeData returns the request body of the rack type.
+func getRackTypeData(api *providerState, d *schema.ResourceData) *writableRackType {
+	model := d.Get("model").(string)
+	slug := getSlug(model)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := &writableRackType{
+		Manufacturer:  int64(d.Get("manufacturer_id").(int)),
+		Model:         model,
+		Slug:          slug,
+		FormFactor:    d.Get("form_factor").(string),
+		Width:         int64(d.Get("width").(int)),
+		UHeight:       int64(d.Get("u_height").(int)),
+		StartingUnit:  int64(d.Get("starting_unit").(int)),
+		DescUnits:     d.Get("desc_units").(bool),
+		OuterWidth:    getOptionalInt(d, "outer_width"),
+		OuterDepth:    getOptionalInt(d, "outer_depth"),
+		Weight:        getOptionalFloat(d, "weight"),
+		MaxWeight:     getOptionalInt(d, "max_weight"),
+		MountingDepth: getOptionalInt(d, "mounting_depth"),
+		Description:   d.Get("description").(string),
+		Comments:      d.Get("comments").(string),
+	}
+
+	if outerUnit, ok := d.GetOk("outer_unit"); ok {
+		data.OuterUnit = strToPtr(outerUnit.(string))
+	}
+	if weightUnit, ok := d.GetOk("weight_unit"); ok {
+		data.WeightUnit = strToPtr(weightUnit.(string))
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
+	return data
+}
+
+func resourceNetboxRackTypeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := apiRequest(api, http.MethodPost, "/dcim/rack-types/", nil, getRackTypeData(api, d))
+	if err != nil {
+		return err
+	}
+
+	id, _ := res.(map[string]interface{})["id"].(json.Number)
+	d.SetId(id.String())
+
+	return resourceNetboxRackTypeRead(d, m)
+}
+
+func resourceNetboxRackTypeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := apiRequest(api, http.MethodGet, "/dcim/rack-types/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	var rt rackType
+	if err := json.Unmarshal(payload, &rt); err != nil {
+		return err
+	}
+
+	d.Set("model", rt.Model)
+	d.Set("slug", rt.Slug)
+
+	if rt.Manufacturer != nil {
+		d.Set("manufacturer_id", rt.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+
+	if rt.FormFactor != nil {
+		d.Set("form_factor", rt.FormFactor.Value)
+	} else {
+		d.Set("form_factor", nil)
+	}
+
+	if rt.Width != nil {
+		d.Set("width", rt.Width.Value)
+	} else {
+		d.Set("width", nil)
+	}
+
+	d.Set("u_height", rt.UHeight)
+	d.Set("starting_unit", rt.StartingUnit)
+	d.Set("desc_units", rt.DescUnits)
+	d.Set("outer_width", rt.OuterWidth)
+	d.Set("outer_depth", rt.OuterDepth)
+
+	if rt.OuterUnit != nil {
+		d.Set("outer_unit", rt.OuterUnit.Value)
+	} else {
+		d.Set("outer_unit", nil)
+	}
+
+	d.Set("weight", rt.Weight)
+	d.Set("max_weight", rt.MaxWeight)
+
+	if rt.WeightUnit != nil {
+		d.Set("weight_unit", rt.WeightUnit.Value)
+	} else {
+		d.Set("weight_unit", nil)
+	}
+
+	d.Set("mounting_depth", rt.MountingDepth)
+	d.Set("description", rt.Description)
+	d.Set("comments", rt.Comments)
+
+	cf := getCustomFields(api, rt.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, rt.Tags))
+
+	setObjectMetadata(d, &rt)
+	return nil
+}
+
+func resourceNetboxRackTypeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	_, err := apiRequest(api, http.MethodPatch, "/dcim/rack-types/"+d.Id()+"/", nil, getRackTypeData(api, d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxRackTypeRead(d, m)
+}
+
+func resourceNetboxRackTypeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	_, err := apiRequest(api, http.MethodDelete, "/dcim/rack-types/"+d.Id()+"/", nil, nil)
+	if err != nil {
+		var errresp *apiRequestError
+		if errors.As(err, &errresp) && errresp.statusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxRackType_basic(t *testing.T) {
+	testSlug := "rack_type_basic"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_rack_type" "test" {
+  model = "%[1]s"
+  slug = "%[2]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  form_factor = "4-post-cabinet"
+  width = 19
+  u_height = 48
+  starting_unit = 2
+  desc_units = true
+  outer_width = 600
+  outer_depth = 1200
+  outer_unit = "mm"
+  weight = 150.5
+  max_weight = 1500
+  weight_unit = "kg"
+  mounting_depth = 1000
+  description = "%[1]s description"
+  comments = "%[1]s comments"
+}
+
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 48
+  rack_type_id = netbox_rack_type.test.id
+}`, testName, getSlug(testName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "model", testName),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttrPair("netbox_rack_type.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "form_factor", "4-post-cabinet"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "width", "19"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "u_height", "48"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "starting_unit", "2"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "desc_units", "true"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "outer_width", "600"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "outer_depth", "1200"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "outer_unit", "mm"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "weight", "150.5"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "max_weight", "1500"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "weight_unit", "kg"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "mounting_depth", "1000"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "comments", testName+" comments"),
+					resource.TestCheckResourceAttrPair("netbox_rack.test", "rack_type_id", "netbox_rack_type.test", "id"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_rack_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  form_factor = "2-post-frame"
+  width = 19
+  u_height = 42
+}
+
+resource "netbox_rack" "test" {
+  name = "%[1]s"
+  site_id = netbox_site.test.id
+  status = "active"
+  width = 19
+  u_height = 48
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "form_factor", "2-post-frame"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "u_height", "42"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "starting_unit", "1"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "desc_units", "false"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "outer_width", "0"),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "outer_unit", ""),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "weight_unit", ""),
+					resource.TestCheckResourceAttr("netbox_rack_type.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_rack.test", "rack_type_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_rack_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_rack_type", &resource.Sweeper{
+		Name:         "netbox_rack_type",
+		Dependencies: []string{"netbox_rack"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			results, err := apiList(api, "/dcim/rack-types/", nil, 0, 0)
+			if err != nil {
+				return err
+			}
+			for _, result := range results {
+				rackType, _ := result.(map[string]interface{})
+				model, _ := rackType["model"].(string)
+				if strings.HasPrefix(model, testPrefix) {
+					id, _ := rackType["id"].(json.Number)
+					_, err := apiRequest(api, http.MethodDelete, "/dcim/rack-types/"+id.String()+"/", nil, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a rack type")
+				}
+			}
+			return nil
+		},
+	})
+}
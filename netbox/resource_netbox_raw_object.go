@@ -0,0 +1,225 @@
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var rawObjectPathPattern = regexp.MustCompile(`^/?(api/)?[a-z0-9_-]+(/[a-z0-9_-]+)+/?$`)
+
+func resourceNetboxRawObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRawObjectCreate,
+		Read:   resourceNetboxRawObjectRead,
+		Update: resourceNetboxRawObjectUpdate,
+		Delete: resourceNetboxRawObjectDelete,
+
+		Description: `:meta:subcategory:Extras:Manages an object of an arbitrary REST API endpoint of Netbox, including endpoints of plugins. Use it for objects that have no dedicated resource yet.
+
+Foreign keys are set by the ID of the referenced object and choice fields by their value, like in the Netbox API. Fields that are removed from ` + "`payload`" + ` keep their value in Netbox. Objects are imported by their path and ID, e.g. ` + "`dcim/devices/12`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(rawObjectPathPattern, "must be an API path like dcim/devices"),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return rawObjectPath(old) == rawObjectPath(new)
+				},
+				Description: "The API path of the endpoint relative to `/api`, e.g. `dcim/devices` or `plugins/bgp/session`.",
+			},
+			"payload": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := jsonSemanticCompare(old, new)
+					return equal
+				},
+				Description: "JSON encoded object with the fields of the object. Changes of these fields in Netbox are detected on refresh.",
+			},
+			"object": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON encoded object as returned by Netbox. Use `jsondecode()` to access its fields.",
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if d.Id() != "" && d.HasChange("payload") {
+				return d.SetNewComputed("object")
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// Filter values may contain slashes, e.g. prefix=10.0.0.0/24
+				end := len(d.Id())
+				if j := strings.Index(d.Id(), "="); j >= 0 {
+					end = j
+				}
+				i := strings.LastIndex(d.Id()[:end], "/")
+				if i < 0 || !rawObjectPathPattern.MatchString(d.Id()[:i]) {
+					return nil, fmt.Errorf("expected <path>/<id> or <path>/<filters> as import ID, e.g. dcim/devices/12, got %q", d.Id())
+				}
+				path := strings.Trim(d.Id()[:i], "/")
+				id, err := importIDByFilter(m, rawObjectPath(path), d.Id()[i+1:])
+				if err != nil {
+					return nil, err
+				}
+				d.Set("path", path)
+				d.Set("payload", "{}")
+				d.SetId(id)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// rawObjectPath returns the path of the endpoint as expected by apiRequest.
+func rawObjectPath(path string) string {
+	return "/" + strings.TrimPrefix(strings.Trim(path, "/"), "api/") + "/"
+}
+
+// decodeRawObject decodes JSON like the responses of apiRequest, keeping
+// numbers as json.Number.
+func decodeRawObject(data string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(data))
+	decoder.UseNumber()
+	var object interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func resourceNetboxRawObjectCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	payload, err := decodeRawObject(d.Get("payload").(string))
+	if err != nil {
+		return err
+	}
+
+	res, err := apiRequest(api, http.MethodPost, rawObjectPath(d.Get("path").(string)), nil, payload)
+	if err != nil {
+		return err
+	}
+
+	object, _ := res.(map[string]interface{})
+	id, ok := object["id"].(json.Number)
+	if !ok {
+		return fmt.Errorf("the object created at %s has no ID", d.Get("path").(string))
+	}
+	d.SetId(id.String())
+
+	return resourceNetboxRawObjectRead(d, m)
+}
+
+func resourceNetboxRawObjectRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	res, err := apiRequest(api, http.MethodGet, rawObjectPath(d.Get("path").(string))+d.Id()+"/", nil, nil)
+	if err != nil {
+		return err
+	}
+
+	object, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	d.Set("object", string(object))
+
+	payload, err := decodeRawObject(d.Get("payload").(string))
+	if err != nil {
+		return err
+	}
+	payloadJSON, err := json.Marshal(projectRawObject(payload, res))
+	if err != nil {
+		return err
+	}
+	d.Set("payload", string(payloadJSON))
+
+	return nil
+}
+
+func resourceNetboxRawObjectUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	payload, err := decodeRawObject(d.Get("payload").(string))
+	if err != nil {
+		return err
+	}
+
+	_, err = apiRequest(api, http.MethodPatch, rawObjectPath(d.Get("path").(string))+d.Id()+"/", nil, payload)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxRawObjectRead(d, m)
+}
+
+func resourceNetboxRawObjectDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	_, err := apiRequest(api, http.MethodDelete, rawObjectPath(d.Get("path").(string))+d.Id()+"/", nil, nil)
+	return err
+}
+
+// projectRawObject returns the parts of the object returned by Netbox that
+// correspond to the payload, in the shape of the payload. Nested objects are
+// reduced to their ID and choices to their value where the payload sets them
+// that way. Fields missing in the response, e.g. write-only fields, keep
+// their value from the payload.
+func projectRawObject(payload, object interface{}) interface{} {
+	switch p := payload.(type) {
+	case map[string]interface{}:
+		o, ok := object.(map[string]interface{})
+		if !ok {
+			return object
+		}
+		result := make(map[string]interface{}, len(p))
+		for key, value := range p {
+			if objectValue, ok := o[key]; ok {
+				result[key] = projectRawObject(value, objectValue)
+			} else {
+				result[key] = value
+			}
+		}
+		return result
+	case []interface{}:
+		o, ok := object.([]interface{})
+		if !ok {
+			return object
+		}
+		result := make([]interface{}, len(o))
+		for i, value := range o {
+			switch {
+			case i < len(p):
+				result[i] = projectRawObject(p[i], value)
+			case len(p) > 0:
+				result[i] = projectRawObject(p[0], value)
+			default:
+				result[i] = value
+			}
+		}
+		return result
+	case json.Number:
+		if o, ok := object.(map[string]interface{}); ok && o["id"] != nil {
+			return o["id"]
+		}
+	case string:
+		if o, ok := object.(map[string]interface{}); ok && o["value"] != nil {
+			return o["value"]
+		}
+	}
+	return object
+}
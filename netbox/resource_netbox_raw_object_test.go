@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxRawObject_basic(t *testing.T) {
+	testSlug := "raw_object"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tenant_group" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_raw_object" "test" {
+  path = "tenancy/tenants"
+  payload = jsonencode({
+    name  = "%[1]s"
+    slug  = "%[2]s"
+    group = netbox_tenant_group.test.id
+  })
+}`, testName, getSlug(testName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netbox_raw_object.test", "object"),
+					resource.TestCheckResourceAttrWith("netbox_raw_object.test", "payload", func(value string) error {
+						var payload map[string]interface{}
+						if err := json.Unmarshal([]byte(value), &payload); err != nil {
+							return err
+						}
+						if payload["name"] != testName {
+							return fmt.Errorf("expected name %s in payload, got %v", testName, payload["name"])
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "netbox_raw_object.test",
+				ImportState:             true,
+				ImportStateIdPrefix:     "tenancy/tenants/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"payload"},
+			},
+		},
+	})
+}
+
+func TestRawObjectPath(t *testing.T) {
+	for _, path := range []string{"dcim/devices", "/dcim/devices/", "api/dcim/devices", "/api/dcim/devices/"} {
+		assert.Equal(t, "/dcim/devices/", rawObjectPath(path), path)
+	}
+}
+
+func TestProjectRawObject(t *testing.T) {
+	payload, err := decodeRawObject(`{"name": "a", "site": 1, "status": "active", "tags": [1], "secret": "x"}`)
+	assert.NoError(t, err)
+	object, err := decodeRawObject(`{
+  "id": 5,
+  "name": "b",
+  "site": {"id": 2, "name": "site"},
+  "status": {"value": "planned", "label": "Planned"},
+  "tags": [{"id": 3}, {"id": 4}],
+  "comments": ""
+}`)
+	assert.NoError(t, err)
+
+	result, err := json.Marshal(projectRawObject(payload, object))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "b", "site": 2, "status": "planned", "tags": [3, 4], "secret": "x"}`, string(result))
+}
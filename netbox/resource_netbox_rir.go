@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxRir() *schema.Resource {
@@ -21,7 +19,7 @@ func resourceNetboxRir() *schema.Resource {
 
 > Regional Internet registries are responsible for the allocation of globally-routable address space. The five RIRs are ARIN, RIPE, APNIC, LACNIC, and AFRINIC. However, some address space has been set aside for internal use, such as defined in RFCs 1918 and 6598. NetBox considers these RFCs as a sort of RIR as well; that is, an authority which "owns" certain address space. There also exist lower-tier registries which serve particular geographic areas.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -30,7 +28,7 @@ func resourceNetboxRir() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -41,7 +39,9 @@ func resourceNetboxRir() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -49,7 +49,7 @@ func resourceNetboxRir() *schema.Resource {
 }
 
 func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.RIR{}
 
 	name := d.Get("name").(string)
@@ -65,9 +65,14 @@ func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
 	data.Name = &name
 	data.Slug = &slug
 	data.Description = getOptionalStr(d, "description", true)
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 	data.IsPrivate = d.Get("is_private").(bool)
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamRirsCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamRirsCreate(params, nil)
 	if err != nil {
@@ -79,7 +84,7 @@ func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRirsReadParams().WithID(id)
 
@@ -103,11 +108,19 @@ func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", rir.Description)
 	d.Set("is_private", rir.IsPrivate)
 
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.RIR{}
 
@@ -124,11 +137,16 @@ func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Name = &name
 	data.Slug = &slug
 	data.Description = getOptionalStr(d, "description", true)
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 	data.IsPrivate = d.Get("is_private").(bool)
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamRirsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamRirsUpdate(params, nil)
+	_, err := api.Ipam.IpamRirsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -136,7 +154,7 @@ func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxRirDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRirsDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamRirsDelete(params, nil)
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +20,7 @@ func resourceNetboxRouteTarget() *schema.Resource {
 
 > A route target is a particular type of extended BGP community used to control the redistribution of routes among VRF tables in a network. Route targets can be assigned to individual VRFs in NetBox as import or export targets (or both) to model this exchange in an L3VPN. Each route target must be given a unique name, which should be in a format prescribed by RFC 4364, similar to a VR route distinguisher.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -36,21 +35,27 @@ func resourceNetboxRouteTarget() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 func resourceNetboxRouteTargetCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableRouteTarget{}
 
 	name := d.Get("name").(string)
 
 	data.Name = &name
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := ipam.NewIpamRouteTargetsCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamRouteTargetsCreate(params, nil)
@@ -63,7 +68,7 @@ func resourceNetboxRouteTargetCreate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRouteTargetsReadParams().WithID(id)
 
@@ -92,15 +97,19 @@ func resourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error
 		d.Set("description", res.GetPayload().Description)
 	}
 
-	if res.GetPayload().Tags != nil {
-		d.Set(tagsKey, res.GetPayload().Tags)
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
 	}
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxRouteTargetUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableRouteTarget{}
 
@@ -111,10 +120,15 @@ func resourceNetboxRouteTargetUpdate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Description = description
 	data.Tenant = &tenantID
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
+
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
 
 	params := ipam.NewIpamRouteTargetsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamRouteTargetsUpdate(params, nil)
+	_, err := api.Ipam.IpamRouteTargetsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -122,7 +136,7 @@ func resourceNetboxRouteTargetUpdate(d *schema.ResourceData, m interface{}) erro
 }
 
 func resourceNetboxRouteTargetDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamRouteTargetsDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamRouteTargetsDelete(params, nil)
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +11,14 @@ import (
 
 var resourceNetboxSiteStatusOptions = []string{"planned", "staging", "active", "decommissioning", "retired"}
 
+// writableSite always sends the facility and time zone so that they can be
+// unset, which go-netbox omits when empty.
+type writableSite struct {
+	models.WritableSite
+	Facility string  `json:"facility"`
+	TimeZone *string `json:"time_zone"`
+}
+
 func resourceNetboxSite() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxSiteCreate,
@@ -25,7 +32,7 @@ func resourceNetboxSite() *schema.Resource {
 >
 > Each site must be assigned a unique name and may optionally be assigned to a region and/or tenant.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,7 +41,7 @@ func resourceNetboxSite() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -52,51 +59,63 @@ func resourceNetboxSite() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 50),
+				Description:  "The local facility ID or description.",
 			},
 			"longitude": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-180, 180),
+				Description:  "The longitude of the site in decimal degrees.",
 			},
 			"latitude": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-90, 90),
+				Description:  "The latitude of the site in decimal degrees.",
 			},
 			"physical_address": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The physical location of the site.",
 			},
 			"shipping_address": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  "The shipping address of the site, if different from the physical address.",
 			},
 			"region_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"region": relatedObjectSchema,
 			"group_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"group": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"tenant": relatedObjectSchema,
+			tagsKey:  tagsSchema,
 			"timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The time zone of the site as IANA time zone name, e.g. `Europe/Berlin`.",
 			},
 			"asn_ids": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the ASNs assigned to the site.",
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
 			},
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -104,7 +123,7 @@ func resourceNetboxSite() *schema.Resource {
 }
 
 func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableSite{}
 
@@ -177,7 +196,7 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := dcim.NewDcimSitesCreateParams().WithData(&data)
@@ -193,7 +212,7 @@ func resourceNetboxSiteCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimSitesReadParams().WithID(id)
 
@@ -230,30 +249,34 @@ func resourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("region_id", nil)
 	}
+	d.Set("region", getRelatedObject(res.GetPayload().Region))
 
 	if res.GetPayload().Group != nil {
 		d.Set("group_id", res.GetPayload().Group.ID)
 	} else {
 		d.Set("group_id", nil)
 	}
+	d.Set("group", getRelatedObject(res.GetPayload().Group))
 
 	if res.GetPayload().Tenant != nil {
 		d.Set("tenant_id", res.GetPayload().Tenant.ID)
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(res.GetPayload().Tenant))
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableSite{}
@@ -336,12 +359,16 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	params := dcim.NewDcimSitesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimSitesPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimSitesPartialUpdate(params, nil, withRequestBody(&writableSite{
+		WritableSite: data,
+		Facility:     data.Facility,
+		TimeZone:     data.TimeZone,
+	}), withClearedStrings(d, "description", "physical_address", "shipping_address"))
 	if err != nil {
 		return err
 	}
@@ -350,7 +377,7 @@ func resourceNetboxSiteUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxSiteDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimSitesDeleteParams().WithID(id)
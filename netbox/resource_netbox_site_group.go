@@ -3,11 +3,9 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxSiteGroup() *schema.Resource {
@@ -23,7 +21,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 >
 > The use of both regions and site groups affords to independent but complementary dimensions across which sites can be organized.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,7 +30,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"parent_id": {
 				Type:     schema.TypeInt,
@@ -42,7 +40,9 @@ func resourceNetboxSiteGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -50,7 +50,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 }
 
 func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 	parentID := int64(d.Get("parent_id").(int))
@@ -69,12 +69,17 @@ func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error
 	data.Name = &name
 	data.Slug = &slug
 	data.Description = description
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimSiteGroupsCreateParams().WithData(data)
 
 	res, err := api.Dcim.DcimSiteGroupsCreate(params, nil)
@@ -88,7 +93,7 @@ func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
 	params := dcim.NewDcimSiteGroupsReadParams().WithID(id)
@@ -113,11 +118,19 @@ func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
 	if siteGroup.Parent != nil {
 		d.Set("parent_id", siteGroup.Parent.ID)
 	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableSiteGroup{}
@@ -138,14 +151,19 @@ func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error
 	data.Slug = &slug
 	data.Name = &name
 	data.Description = description
-	data.Tags = []*models.NestedTag{}
+	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags = tags
 
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := dcim.NewDcimSiteGroupsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimSiteGroupsPartialUpdate(params, nil)
+	_, err := api.Dcim.DcimSiteGroupsPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -154,7 +172,7 @@ func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxSiteGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimSiteGroupsDeleteParams().WithID(id)
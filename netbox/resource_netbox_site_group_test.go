@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -21,10 +20,15 @@ func TestAccNetboxSiteGroup_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
 resource "netbox_site_group" "parent" {
   name = "%[1]s"
   slug = "%[2]s"
   description = "foo bar."
+  tags = [netbox_tag.test.name]
 }
 
 resource "netbox_site_group" "child" {
@@ -37,6 +41,8 @@ resource "netbox_site_group" "child" {
 					resource.TestCheckResourceAttr("netbox_site_group.parent", "name", testName),
 					resource.TestCheckResourceAttr("netbox_site_group.parent", "slug", randomSlug),
 					resource.TestCheckResourceAttr("netbox_site_group.parent", "description", "foo bar."),
+					resource.TestCheckResourceAttr("netbox_site_group.parent", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_site_group.parent", "tags.0", testName),
 					resource.TestCheckResourceAttr("netbox_site_group.child", "name", fmt.Sprintf("%s-child", testName)),
 					resource.TestCheckResourceAttr("netbox_site_group.child", "slug", fmt.Sprintf("%s-c", randomSlug)),
 					resource.TestCheckResourceAttrPair("netbox_site_group.child", "parent_id", "netbox_site_group.parent", "id"),
@@ -72,6 +78,33 @@ resource "netbox_site_group" "test" {
 	})
 }
 
+func TestAccNetboxSiteGroup_customFields(t *testing.T) {
+	testSlug := "s_grp_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+	name          = "%[1]s"
+	type          = "text"
+	content_types = ["dcim.sitegroup"]
+}
+resource "netbox_site_group" "test" {
+  name          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site_group.test", "custom_fields."+testField, "81"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_site_group", &resource.Sweeper{
 		Name:         "netbox_site_group",
@@ -81,7 +114,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimSiteGroupsListParams()
 			res, err := api.Dcim.DcimSiteGroupsList(params, nil)
 			if err != nil {
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -149,6 +148,8 @@ resource "netbox_site" "test" {
 	description = "Test site description"
 	physical_address = "Physical address"
 	shipping_address = "Shipping address"
+	facility = "Facility"
+	timezone = "Europe/Berlin"
 	latitude      = "12.123456"
   	longitude     = "-13.123456"
 
@@ -157,6 +158,8 @@ resource "netbox_site" "test" {
 					resource.TestCheckResourceAttr("netbox_site.test", "description", "Test site description"),
 					resource.TestCheckResourceAttr("netbox_site.test", "physical_address", "Physical address"),
 					resource.TestCheckResourceAttr("netbox_site.test", "shipping_address", "Shipping address"),
+					resource.TestCheckResourceAttr("netbox_site.test", "facility", "Facility"),
+					resource.TestCheckResourceAttr("netbox_site.test", "timezone", "Europe/Berlin"),
 					resource.TestCheckResourceAttr("netbox_site.test", "latitude", "12.123456"),
 					resource.TestCheckResourceAttr("netbox_site.test", "longitude", "-13.123456"),
 				)},
@@ -169,6 +172,8 @@ resource "netbox_site" "test" {
 					resource.TestCheckResourceAttr("netbox_site.test", "description", ""),
 					resource.TestCheckResourceAttr("netbox_site.test", "physical_address", ""),
 					resource.TestCheckResourceAttr("netbox_site.test", "shipping_address", ""),
+					resource.TestCheckResourceAttr("netbox_site.test", "facility", ""),
+					resource.TestCheckResourceAttr("netbox_site.test", "timezone", ""),
 					resource.TestCheckResourceAttr("netbox_site.test", "latitude", "0"),
 					resource.TestCheckResourceAttr("netbox_site.test", "longitude", "0"),
 				),
@@ -186,7 +191,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := dcim.NewDcimSitesListParams()
 			res, err := api.Dcim.DcimSitesList(params, nil)
 			if err != nil {
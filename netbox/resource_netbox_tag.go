@@ -1,14 +1,11 @@
 package netbox
 
 import (
-	"regexp"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxTag() *schema.Resource {
@@ -23,7 +20,7 @@ func resourceNetboxTag() *schema.Resource {
 >
 > Each tag has a label, color, and a URL-friendly slug. For example, the slug for a tag named "Dunder Mifflin, Inc." would be dunder-mifflin-inc. The slug is generated automatically and makes tags easier to work with as URL parameters. Each tag can also be assigned a description indicating its purpose.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,20 +29,20 @@ func resourceNetboxTag() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"color_hex": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "9e9e9e",
-				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{6}$"), "Must be hex color string"),
+				ValidateFunc: validateColorHex,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			tagsKey: tagsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -53,7 +50,7 @@ func resourceNetboxTag() *schema.Resource {
 }
 
 func resourceNetboxTagCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -89,7 +86,7 @@ func resourceNetboxTagCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasTagsReadParams().WithID(id)
 
@@ -110,11 +107,13 @@ func resourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("color_hex", res.GetPayload().Color)
 	d.Set("description", res.GetPayload().Description)
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxTagUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Tag{}
@@ -139,7 +138,7 @@ func resourceNetboxTagUpdate(d *schema.ResourceData, m interface{}) error {
 
 	params := extras.NewExtrasTagsUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasTagsUpdate(params, nil)
+	_, err := api.Extras.ExtrasTagsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -148,7 +147,7 @@ func resourceNetboxTagUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxTagDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := extras.NewExtrasTagsDeleteParams().WithID(id)
@@ -0,0 +1,329 @@
+package netbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// tagAssignmentContentTypes are the API paths of the object types that
+// netbox_tag_assignment can tag, by content type.
+var tagAssignmentContentTypes = map[string]string{
+	"circuits.circuit":              "/circuits/circuits/",
+	"circuits.provider":             "/circuits/providers/",
+	"dcim.cable":                    "/dcim/cables/",
+	"dcim.device":                   "/dcim/devices/",
+	"dcim.devicetype":               "/dcim/device-types/",
+	"dcim.interface":                "/dcim/interfaces/",
+	"dcim.inventoryitem":            "/dcim/inventory-items/",
+	"dcim.location":                 "/dcim/locations/",
+	"dcim.module":                   "/dcim/modules/",
+	"dcim.powerfeed":                "/dcim/power-feeds/",
+	"dcim.powerpanel":               "/dcim/power-panels/",
+	"dcim.rack":                     "/dcim/racks/",
+	"dcim.region":                   "/dcim/regions/",
+	"dcim.site":                     "/dcim/sites/",
+	"ipam.aggregate":                "/ipam/aggregates/",
+	"ipam.asn":                      "/ipam/asns/",
+	"ipam.ipaddress":                "/ipam/ip-addresses/",
+	"ipam.iprange":                  "/ipam/ip-ranges/",
+	"ipam.prefix":                   "/ipam/prefixes/",
+	"ipam.service":                  "/ipam/services/",
+	"ipam.vlan":                     "/ipam/vlans/",
+	"ipam.vrf":                      "/ipam/vrfs/",
+	"tenancy.contact":               "/tenancy/contacts/",
+	"tenancy.tenant":                "/tenancy/tenants/",
+	"virtualization.cluster":        "/virtualization/clusters/",
+	"virtualization.virtualmachine": "/virtualization/virtual-machines/",
+	"virtualization.vminterface":    "/virtualization/interfaces/",
+}
+
+func resourceNetboxTagAssignment() *schema.Resource {
+	var contentTypes []string
+	for contentType := range tagAssignmentContentTypes {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+
+	return &schema.Resource{
+		Create: resourceNetboxTagAssignmentCreate,
+		Read:   resourceNetboxTagAssignmentRead,
+		Update: resourceNetboxTagAssignmentUpdate,
+		Delete: resourceNetboxTagAssignmentDelete,
+
+		Description: `:meta:subcategory:Extras:Applies a tag to objects of one type that are not managed by Terraform, e.g. to mark devices created by other tooling as in scope. Only the tag is managed: the other tags of the objects are kept, and destroying the resource only removes the tag again. The objects are updated with the bulk endpoints of the Netbox API, in batches of ` + "`batch_size`" + ` objects.
+
+Import an existing assignment as ` + "`<content_type>/<tag>`" + `, e.g. ` + "`dcim.device/in-scope`" + `, which imports all objects of the type that have the tag.`,
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the tag.",
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(contentTypes, false),
+				Description:  buildValidValueDescription(contentTypes),
+			},
+			"object_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Description: "The IDs of the objects that get the tag.",
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The maximum number of objects read or updated in a single request.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				contentType, tag, ok := strings.Cut(d.Id(), "/")
+				if !ok || contentType == "" || tag == "" {
+					return nil, fmt.Errorf("expected <content_type>/<tag>, got %q", d.Id())
+				}
+				if strings.Contains(tag, "=") {
+					// The tag is given by filters, but the assignment uses its name
+					api, ok := m.(*providerState)
+					if !ok {
+						return nil, fmt.Errorf("%s can not be imported without a connection to Netbox", d.Id())
+					}
+					tagID, err := getIDByFilter(api, importPaths["netbox_tag_assignment"], tag)
+					if err != nil {
+						return nil, err
+					}
+					res, err := apiRequest(api, http.MethodGet, fmt.Sprintf("%s%d/", importPaths["netbox_tag_assignment"], tagID), nil, nil)
+					if err != nil {
+						return nil, err
+					}
+					tag, _ = res.(map[string]interface{})["name"].(string)
+				}
+				d.Set("content_type", contentType)
+				d.Set("tag", tag)
+				d.Set("batch_size", 100)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+// getTagAssignmentTag returns the ID and slug of the tag with the given name.
+func getTagAssignmentTag(api *providerState, name string) (int64, string, error) {
+	results, err := apiList(api, "/extras/tags/", url.Values{"name": {name}}, 2, 0)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(results) != 1 {
+		return 0, "", fmt.Errorf("%w matching name=%s", errNoObjectFound, name)
+	}
+	tag, _ := results[0].(map[string]interface{})
+	tagID, _ := tag["id"].(json.Number).Int64()
+	slug, _ := tag["slug"].(string)
+	return tagID, slug, nil
+}
+
+// getTagAssignmentObjects returns the objects of the given type with the
+// given IDs, read in batches of batchSize objects. The query may filter the
+// objects further.
+func getTagAssignmentObjects(api *providerState, path string, objectIDs []int, query url.Values, batchSize int) ([]map[string]interface{}, error) {
+	var objects []map[string]interface{}
+	for start := 0; start < len(objectIDs); start += batchSize {
+		end := min(start+batchSize, len(objectIDs))
+		batchQuery := url.Values{}
+		for key, values := range query {
+			batchQuery[key] = values
+		}
+		for _, objectID := range objectIDs[start:end] {
+			batchQuery.Add("id", strconv.Itoa(objectID))
+		}
+		results, err := apiList(api, path, batchQuery, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			object, _ := result.(map[string]interface{})
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+// setTagAssignmentTag adds the tag to or removes it from the objects with the
+// given IDs and keeps their other tags. Objects that do not need to change are
+// not updated. If add is true, all objects must exist.
+func setTagAssignmentTag(api *providerState, path string, objectIDs []int, tagID int64, add bool, batchSize int) error {
+	objects, err := getTagAssignmentObjects(api, path, objectIDs, nil, batchSize)
+	if err != nil {
+		return err
+	}
+	if add && len(objects) != len(objectIDs) {
+		found := make(map[int64]bool)
+		for _, object := range objects {
+			objectID, _ := object["id"].(json.Number).Int64()
+			found[objectID] = true
+		}
+		var missing []string
+		for _, objectID := range objectIDs {
+			if !found[int64(objectID)] {
+				missing = append(missing, strconv.Itoa(objectID))
+			}
+		}
+		return fmt.Errorf("the objects with the IDs %s do not exist at %s", strings.Join(missing, ", "), path)
+	}
+
+	var updates []map[string]interface{}
+	for _, object := range objects {
+		var tags []map[string]interface{}
+		hasTag := false
+		existing, _ := object["tags"].([]interface{})
+		for _, tag := range existing {
+			id, _ := tag.(map[string]interface{})["id"].(json.Number).Int64()
+			if id == tagID {
+				hasTag = true
+				continue
+			}
+			tags = append(tags, map[string]interface{}{"id": id})
+		}
+		if hasTag == add {
+			continue
+		}
+		if add {
+			tags = append(tags, map[string]interface{}{"id": tagID})
+		}
+		if tags == nil {
+			tags = []map[string]interface{}{}
+		}
+		updates = append(updates, map[string]interface{}{"id": object["id"], "tags": tags})
+	}
+	_, err = apiBulkRequest(api, http.MethodPatch, path, updates, batchSize)
+	return err
+}
+
+func getTagAssignmentObjectIDs(set interface{}) []int {
+	var objectIDs []int
+	for _, objectID := range set.(*schema.Set).List() {
+		objectIDs = append(objectIDs, objectID.(int))
+	}
+	sort.Ints(objectIDs)
+	return objectIDs
+}
+
+func resourceNetboxTagAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	contentType := d.Get("content_type").(string)
+	tag := d.Get("tag").(string)
+	tagID, _, err := getTagAssignmentTag(api, tag)
+	if err != nil {
+		return err
+	}
+
+	err = setTagAssignmentTag(api, tagAssignmentContentTypes[contentType], getTagAssignmentObjectIDs(d.Get("object_ids")), tagID, true, d.Get("batch_size").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(contentType + "/" + tag)
+
+	return resourceNetboxTagAssignmentRead(d, m)
+}
+
+func resourceNetboxTagAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	path, ok := tagAssignmentContentTypes[d.Get("content_type").(string)]
+	if !ok {
+		return fmt.Errorf("the content type %q can not be tagged by this resource", d.Get("content_type"))
+	}
+	_, slug, err := getTagAssignmentTag(api, d.Get("tag").(string))
+	if errors.Is(err, errNoObjectFound) {
+		// The tag was deleted outside of Terraform.
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var objects []interface{}
+	query := url.Values{"tag": {slug}}
+	objectIDs := getTagAssignmentObjectIDs(d.Get("object_ids"))
+	if len(objectIDs) == 0 {
+		// On import, all objects with the tag are assigned.
+		objects, err = apiList(api, path, query, 0, 0)
+	} else {
+		var tagged []map[string]interface{}
+		tagged, err = getTagAssignmentObjects(api, path, objectIDs, query, d.Get("batch_size").(int))
+		for _, object := range tagged {
+			objects = append(objects, object)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	var taggedIDs []int
+	for _, object := range objects {
+		objectID, _ := object.(map[string]interface{})["id"].(json.Number).Int64()
+		taggedIDs = append(taggedIDs, int(objectID))
+	}
+	d.Set("object_ids", taggedIDs)
+
+	return nil
+}
+
+func resourceNetboxTagAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	path := tagAssignmentContentTypes[d.Get("content_type").(string)]
+	tagID, _, err := getTagAssignmentTag(api, d.Get("tag").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("object_ids") {
+		batchSize := d.Get("batch_size").(int)
+		old, new := d.GetChange("object_ids")
+		removed := old.(*schema.Set).Difference(new.(*schema.Set))
+		added := new.(*schema.Set).Difference(old.(*schema.Set))
+		if err := setTagAssignmentTag(api, path, getTagAssignmentObjectIDs(removed), tagID, false, batchSize); err != nil {
+			return err
+		}
+		if err := setTagAssignmentTag(api, path, getTagAssignmentObjectIDs(added), tagID, true, batchSize); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetboxTagAssignmentRead(d, m)
+}
+
+func resourceNetboxTagAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	tagID, _, err := getTagAssignmentTag(api, d.Get("tag").(string))
+	if errors.Is(err, errNoObjectFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return setTagAssignmentTag(api, tagAssignmentContentTypes[d.Get("content_type").(string)], getTagAssignmentObjectIDs(d.Get("object_ids")), tagID, false, d.Get("batch_size").(int))
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxTagAssignment_basic(t *testing.T) {
+	testSlug := "tag_assignment"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "other" {
+  name = "%[1]s-other"
+}
+
+resource "netbox_tenant" "test" {
+  count = 3
+  name  = "%[1]s-${count.index}"
+  tags  = [netbox_tag.other.name]
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + `
+resource "netbox_tag_assignment" "test" {
+  tag          = netbox_tag.test.name
+  content_type = "tenancy.tenant"
+  object_ids   = netbox_tenant.test[*].id
+  batch_size   = 2
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tag_assignment.test", "id", "tenancy.tenant/"+testName),
+					resource.TestCheckResourceAttr("netbox_tag_assignment.test", "object_ids.#", "3"),
+				),
+			},
+			{
+				Config: dependencies + `
+resource "netbox_tag_assignment" "test" {
+  tag          = netbox_tag.test.name
+  content_type = "tenancy.tenant"
+  object_ids   = [netbox_tenant.test[0].id]
+}
+
+data "netbox_tenants" "test" {
+  tags = [netbox_tag.test.slug, netbox_tag.other.slug]
+
+  depends_on = [netbox_tag_assignment.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tag_assignment.test", "object_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_tenants.test", "tenants.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "netbox_tag_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+type tagAssignmentUpdate struct {
+	ID   int              `json:"id"`
+	Tags []map[string]int `json:"tags"`
+}
+
+func TestSetTagAssignmentTag(t *testing.T) {
+	var updates []tagAssignmentUpdate
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "/api/dcim/devices/", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"count": 3, "results": [
+				{"id": 1, "tags": [{"id": 7}]},
+				{"id": 2, "tags": [{"id": 7}, {"id": 9}]},
+				{"id": 3, "tags": []}
+			]}`))
+		case http.MethodPatch:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updates))
+			w.Write([]byte(`[]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:       "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:      ts.URL,
+		RequestTimeout: 10,
+	}
+	netboxClient, err := config.Client()
+	assert.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient}
+
+	t.Run("Add", func(t *testing.T) {
+		updates = nil
+		assert.NoError(t, setTagAssignmentTag(api, "/dcim/devices/", []int{1, 2, 3}, 9, true, 100))
+		assert.Equal(t, []tagAssignmentUpdate{
+			{ID: 1, Tags: []map[string]int{{"id": 7}, {"id": 9}}},
+			{ID: 3, Tags: []map[string]int{{"id": 9}}},
+		}, updates)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		updates = nil
+		assert.NoError(t, setTagAssignmentTag(api, "/dcim/devices/", []int{1, 2, 3}, 7, false, 100))
+		assert.Equal(t, []tagAssignmentUpdate{
+			{ID: 1, Tags: []map[string]int{}},
+			{ID: 2, Tags: []map[string]int{{"id": 9}}},
+		}, updates)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		err := setTagAssignmentTag(api, "/dcim/devices/", []int{1, 2, 3, 4}, 9, true, 100)
+		assert.ErrorContains(t, err, "the objects with the IDs 4 do not exist")
+	})
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,16 +20,32 @@ func resourceNetboxToken() *schema.Resource {
 
 > A token is a unique identifier mapped to a NetBox user account. Each user may have one or more tokens which he or she can use for authentication when making REST API requests. To create a token, navigate to the API tokens page under your user profile.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"user_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
 			"key": {
+				Type:          schema.TypeString,
+				Sensitive:     true,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(40, 256),
+				ConflictsWith: []string{"key_wo"},
+			},
+			"key_wo": {
 				Type:         schema.TypeString,
 				Sensitive:    true,
 				Optional:     true,
+				WriteOnly:    true,
 				ValidateFunc: validation.StringLenBetween(40, 256),
+				RequiredWith: []string{"key_wo_version"},
+				Description:  "Write-only variant of `key` that is never stored in the state. Requires Terraform 1.11 or later.",
+			},
+			"key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"key_wo"},
+				Description:  "Version of `key_wo`. Because write-only values are not stored, change this value to update the key.",
 			},
 			"allowed_ips": {
 				Type:     schema.TypeList,
@@ -56,7 +71,7 @@ func resourceNetboxToken() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -64,12 +79,12 @@ func resourceNetboxToken() *schema.Resource {
 }
 
 func resourceNetboxTokenCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableToken{}
 
 	userid := int64(d.Get("user_id").(int))
 
-	key := d.Get("key").(string)
+	key := getTokenKey(d)
 	allowedIps := d.Get("allowed_ips").([]interface{})
 
 	data.User = &userid
@@ -94,7 +109,7 @@ func resourceNetboxTokenCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersTokensReadParams().WithID(id)
 
@@ -116,23 +131,27 @@ func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("user_id", token.User.ID)
 	}
 
-	d.Set("key", token.Key)
+	// Keep the key out of the state if it was given as write-only attribute
+	if _, ok := d.GetOk("key_wo_version"); !ok {
+		d.Set("key", token.Key)
+	}
 	d.Set("last_used", token.LastUsed)
 	d.Set("expires", token.Expires)
 	d.Set("allowed_ips", token.AllowedIps)
 	d.Set("write_enabled", token.WriteEnabled)
 	d.Set("description", token.Description)
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableToken{}
 
 	userid := int64(d.Get("user_id").(int))
-	key := d.Get("key").(string)
+	key := getTokenKey(d)
 	allowedIps := d.Get("allowed_ips").([]interface{})
 
 	data.User = &userid
@@ -147,7 +166,7 @@ func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = d.Get("description").(string)
 
 	params := users.NewUsersTokensUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Users.UsersTokensUpdate(params, nil)
+	_, err := api.Users.UsersTokensUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -155,7 +174,7 @@ func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxTokenDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersTokensDeleteParams().WithID(id)
 	_, err := api.Users.UsersTokensDelete(params, nil)
@@ -171,3 +190,12 @@ func resourceNetboxTokenDelete(d *schema.ResourceData, m interface{}) error {
 	d.SetId("")
 	return nil
 }
+
+// getTokenKey returns the key from either key or the write-only key_wo
+// attribute.
+func getTokenKey(d *schema.ResourceData) string {
+	if key, ok := d.GetOk("key"); ok {
+		return key.(string)
+	}
+	return getWriteOnlyStr(d, "key_wo")
+}
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,15 +17,30 @@ func resourceNetboxUser() *schema.Resource {
 
 		Description: `:meta:subcategory:Authentication:This resource is used to manage users.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"username": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"password_wo_version"},
+				Description:  "Write-only variant of `password` that is never stored in the state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. Because write-only values are not stored, change this value to update the password.",
 			},
 			"active": {
 				Type:     schema.TypeBool,
@@ -45,18 +59,18 @@ func resourceNetboxUser() *schema.Resource {
 					Type: schema.TypeInt,
 				},
 			},
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 func resourceNetboxUserCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableUser{}
 
 	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	password := getUserPassword(d)
 	active := d.Get("active").(bool)
 	staff := d.Get("staff").(bool)
 	groupIDs := toInt64List(d.Get("group_ids"))
@@ -78,7 +92,7 @@ func resourceNetboxUserCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxUserRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersUsersReadParams().WithID(id)
 
@@ -105,16 +119,17 @@ func resourceNetboxUserRead(d *schema.ResourceData, m interface{}) error {
 
 	// Passwords cannot be set and not read
 
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxUserUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableUser{}
 
 	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	password := getUserPassword(d)
 	active := d.Get("active").(bool)
 	staff := d.Get("staff").(bool)
 	groupIDs := toInt64List(d.Get("group_ids"))
@@ -134,7 +149,7 @@ func resourceNetboxUserUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxUserDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := users.NewUsersUsersDeleteParams().WithID(id)
 	_, err := api.Users.UsersUsersDelete(params, nil)
@@ -158,3 +173,12 @@ func getIDsFromNestedGroup(nestedGroups []*models.NestedGroup) []int64 {
 	}
 	return groupIDs
 }
+
+// getUserPassword returns the password from either password or the write-only
+// password_wo attribute.
+func getUserPassword(d *schema.ResourceData) string {
+	if password, ok := d.GetOk("password"); ok {
+		return password.(string)
+	}
+	return getWriteOnlyStr(d, "password_wo")
+}
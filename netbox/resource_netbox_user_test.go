@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -15,8 +14,8 @@ func TestAccNetboxUser_basic(t *testing.T) {
 	testSlug := "users"
 	testName := testAccGetTestName(testSlug)
 	resource.ParallelTest(t, resource.TestCase{
-		Providers: testAccProviders,
-		PreCheck:  func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
@@ -45,8 +44,8 @@ func TestAccNetboxUser_group(t *testing.T) {
 	testSlug := "users"
 	testName := testAccGetTestName(testSlug)
 	resource.ParallelTest(t, resource.TestCase{
-		Providers: testAccProviders,
-		PreCheck:  func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
@@ -77,6 +76,31 @@ resource "netbox_user" "test_group" {
 	})
 }
 
+func TestAccNetboxUser_passwordWriteOnly(t *testing.T) {
+	testSlug := "users_wo"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_user" "test_wo" {
+  username            = "%s"
+  password_wo         = "abcdefghijkl"
+  password_wo_version = 1
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_user.test_wo", "username", testName),
+					resource.TestCheckResourceAttr("netbox_user.test_wo", "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("netbox_user.test_wo", "password"),
+					resource.TestCheckNoResourceAttr("netbox_user.test_wo", "password_wo"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_user", &resource.Sweeper{
 		Name:         "netbox_user",
@@ -86,7 +110,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := users.NewUsersUsersListParams()
 			res, err := api.Users.UsersUsersList(params, nil)
 			if err != nil {
@@ -2,9 +2,11 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,7 +23,7 @@ func resourceNetboxVirtualChassis() *schema.Resource {
 
 		> Sometimes it is necessary to model a set of physical devices as sharing a single management plane. Perhaps the most common example of such a scenario is stackable switches. These can be modeled as virtual chassis in NetBox, with one device acting as the chassis master and the rest as members. All components of member devices will appear on the master.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -38,9 +40,38 @@ func resourceNetboxVirtualChassis() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"master_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the member device that is the master of the virtual chassis.",
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"position": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The position of the device in the virtual chassis.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The priority of the device in the master election.",
+						},
+					},
+				},
+				Description: "The member devices of the virtual chassis. If set, devices are added to and removed from the virtual chassis to match. Do not set the `virtual_chassis_*` attributes of `netbox_device` for the same devices. Removing the attribute leaves the members unchanged.",
+			},
 			tagsKey:         tagsSchema,
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -48,7 +79,7 @@ func resourceNetboxVirtualChassis() *schema.Resource {
 }
 
 func resourceNetboxVirtualChassisCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -76,7 +107,7 @@ func resourceNetboxVirtualChassisCreate(ctx context.Context, d *schema.ResourceD
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
@@ -90,11 +121,22 @@ func resourceNetboxVirtualChassisCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	if members, ok := d.GetOk("member"); ok {
+		if err := setVirtualChassisMembers(api, res.GetPayload().ID, nil, members.(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if master := getOptionalInt(d, "master_id"); master != nil {
+		if err := virtualChassisUpdateMaster(api, res.GetPayload().ID, master); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetboxVirtualChassisRead(ctx, d, m)
 }
 
 func resourceNetboxVirtualChassisRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -118,18 +160,39 @@ func resourceNetboxVirtualChassisRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("domain", virtualChassis.Domain)
 	d.Set("description", virtualChassis.Description)
 	d.Set("comments", virtualChassis.Comments)
+	if virtualChassis.Master != nil {
+		d.Set("master_id", virtualChassis.Master.ID)
+	} else {
+		d.Set("master_id", nil)
+	}
+
+	members, err := getVirtualChassisMembers(api, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var memberList []map[string]interface{}
+	for _, member := range members {
+		memberList = append(memberList, map[string]interface{}{
+			"device_id": member["device_id"],
+			"position":  member["position"],
+			"priority":  member["priority"],
+		})
+	}
+	d.Set("member", memberList)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(virtualChassis.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, virtualChassis.Tags))
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableVirtualChassis{}
@@ -145,7 +208,7 @@ func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceD
 
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
@@ -167,9 +230,31 @@ func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChanges("master_id", "member") {
+		// The master has to be a member, so it is unset while the members
+		// change.
+		oldMaster, _ := d.GetChange("master_id")
+		if oldMaster.(int) != 0 {
+			if err := virtualChassisUpdateMaster(api, id, nil); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange("member") {
+			oldMembers, newMembers := d.GetChange("member")
+			if err := setVirtualChassisMembers(api, id, oldMembers.(*schema.Set).List(), newMembers.(*schema.Set).List()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if master := getOptionalInt(d, "master_id"); master != nil {
+			if err := virtualChassisUpdateMaster(api, id, master); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	params := dcim.NewDcimVirtualChassisUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimVirtualChassisUpdate(params, nil)
+	_, err := api.Dcim.DcimVirtualChassisUpdate(params, nil, withClearedStrings(d, "comments", "description"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -178,7 +263,7 @@ func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetboxVirtualChassisDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := dcim.NewDcimVirtualChassisDeleteParams().WithID(id)
@@ -197,7 +282,7 @@ func resourceNetboxVirtualChassisDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func virtualChassisUpdateMaster(api *client.NetBoxAPI, id int64, master *int64) error {
+func virtualChassisUpdateMaster(api *providerState, id int64, master *int64) error {
 	// Need to read the virtual chassis because we cannot do a partial update
 	// because setting `master` to nil would omit it entirely, so we need to
 	// do a PUT request instead of PATCH
@@ -230,3 +315,68 @@ func virtualChassisUpdateMaster(api *client.NetBoxAPI, id int64, master *int64)
 	_, err = api.Dcim.DcimVirtualChassisUpdate(dcim.NewDcimVirtualChassisUpdateParams().WithID(id).WithData(&vcUpdateData), nil)
 	return err
 }
+
+// getVirtualChassisMembers returns the devices of the virtual chassis with
+// their ID, name, position and priority.
+func getVirtualChassisMembers(api *providerState, id int64) ([]map[string]interface{}, error) {
+	devices, err := apiList(api, "/dcim/devices/", url.Values{"virtual_chassis_id": {strconv.FormatInt(id, 10)}}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []map[string]interface{}
+	for _, device := range devices {
+		device, _ := device.(map[string]interface{})
+		member := map[string]interface{}{
+			"name": device["name"],
+		}
+		for key, field := range map[string]string{"device_id": "id", "position": "vc_position", "priority": "vc_priority"} {
+			if value, ok := device[field].(json.Number); ok {
+				number, _ := value.Int64()
+				member[key] = int(number)
+			}
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// setVirtualChassisMembers removes the devices of oldMembers that are not in
+// newMembers from the virtual chassis and adds or updates the devices of
+// newMembers, using the bulk endpoint for devices.
+func setVirtualChassisMembers(api *providerState, id int64, oldMembers, newMembers []interface{}) error {
+	var removed, updated []map[string]interface{}
+	members := make(map[int]bool)
+	for _, member := range newMembers {
+		member := member.(map[string]interface{})
+		members[member["device_id"].(int)] = true
+		var priority interface{}
+		if value := member["priority"].(int); value != 0 {
+			priority = value
+		}
+		updated = append(updated, map[string]interface{}{
+			"id":              member["device_id"],
+			"virtual_chassis": id,
+			"vc_position":     member["position"],
+			"vc_priority":     priority,
+		})
+	}
+	for _, member := range oldMembers {
+		deviceID := member.(map[string]interface{})["device_id"].(int)
+		if !members[deviceID] {
+			removed = append(removed, map[string]interface{}{
+				"id":              deviceID,
+				"virtual_chassis": nil,
+				"vc_position":     nil,
+				"vc_priority":     nil,
+			})
+		}
+	}
+
+	// Positions of removed devices may be taken by updated devices.
+	if _, err := apiBulkRequest(api, http.MethodPatch, "/dcim/devices/", removed, 100); err != nil {
+		return err
+	}
+	_, err := apiBulkRequest(api, http.MethodPatch, "/dcim/devices/", updated, 100)
+	return err
+}
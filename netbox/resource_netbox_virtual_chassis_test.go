@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -59,8 +58,110 @@ resource "netbox_virtual_chassis" "test" {
 	})
 }
 
+func testAccNetboxVirtualChassisMemberDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name   = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  count          = 3
+  name           = "%[1]s-${count.index}"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+
+  lifecycle {
+    ignore_changes = [virtual_chassis_id, virtual_chassis_position, virtual_chassis_priority, virtual_chassis_master]
+  }
+}
+`, testName)
+}
+
+func TestAccNetboxVirtualChassis_members(t *testing.T) {
+	testSlug := "virtual_chassis_members"
+	testName := testAccGetTestName(testSlug)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualChassisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualChassisMemberDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_chassis" "test" {
+  name      = "%[1]s"
+  master_id = netbox_device.test[0].id
+
+  member {
+    device_id = netbox_device.test[0].id
+    position  = 1
+    priority  = 200
+  }
+  member {
+    device_id = netbox_device.test[1].id
+    position  = 2
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_virtual_chassis.test", "master_id", "netbox_device.test.0", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_chassis.test", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_virtual_chassis.test", "member.*", map[string]string{
+						"position": "1",
+						"priority": "200",
+					}),
+				),
+			},
+			{
+				Config: testAccNetboxVirtualChassisMemberDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_chassis" "test" {
+  name      = "%[1]s"
+  master_id = netbox_device.test[2].id
+
+  member {
+    device_id = netbox_device.test[1].id
+    position  = 1
+  }
+  member {
+    device_id = netbox_device.test[2].id
+    position  = 2
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_virtual_chassis.test", "master_id", "netbox_device.test.2", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_chassis.test", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_virtual_chassis.test", "member.*", map[string]string{
+						"position": "2",
+						"priority": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtual_chassis.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckVirtualChassisDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*client.NetBoxAPI)
+	conn := testAccProvider.Meta().(*providerState)
 
 	// loop through the resources in state, verifying each virtual machine
 	// is destroyed
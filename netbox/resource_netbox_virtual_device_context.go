@@ -0,0 +1,241 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxVirtualDeviceContextStatusOptions = []string{"active", "planned", "offline"}
+
+// writableVDC shadows the fields of the go-netbox model that are omitted when
+// empty, so they can be unset.
+type writableVDC struct {
+	models.WritableVirtualDeviceContext
+	Identifier *int64 `json:"identifier"`
+	Tenant     *int64 `json:"tenant"`
+	PrimaryIp4 *int64 `json:"primary_ip4"`
+	PrimaryIp6 *int64 `json:"primary_ip6"`
+}
+
+func resourceNetboxVirtualDeviceContext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualDeviceContextCreate,
+		Read:   resourceNetboxVirtualDeviceContextRead,
+		Update: resourceNetboxVirtualDeviceContextUpdate,
+		Delete: resourceNetboxVirtualDeviceContextDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/virtualdevicecontext/):
+
+> A virtual device context (VDC) represents a logical partition within a physical device, to which interfaces from the parent device can be allocated. Each VDC effectively provides an isolated control plane, but relies on shared resources of the parent device. A VDC is somewhat similar to a virtual machine in that it effects isolation between various components, but stops short of delivering a fully virtualized environment.`,
+
+		Schema: withObjectMetadata(map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxVirtualDeviceContextStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVirtualDeviceContextStatusOptions),
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Numeric identifier of the context, unique to the parent device.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"primary_ipv4": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the primary IPv4 address of the context.",
+			},
+			"primary_ipv6": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the primary IPv6 address of the context.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"interface_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualDeviceContextCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	data := models.WritableVirtualDeviceContext{
+		Name:        strToPtr(d.Get("name").(string)),
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Status:      strToPtr(d.Get("status").(string)),
+		Identifier:  getOptionalInt(d, "identifier"),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		PrimaryIp4:  getOptionalInt(d, "primary_ipv4"),
+		PrimaryIp6:  getOptionalInt(d, "primary_ipv6"),
+		Description: getOptionalStr(d, "description", false),
+		Comments:    getOptionalStr(d, "comments", false),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	ct, ok := d.GetOk(customFieldsKey)
+	if ok {
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
+	}
+
+	params := dcim.NewDcimVirtualDeviceContextsCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimVirtualDeviceContextsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxVirtualDeviceContextRead(d, m)
+}
+
+func resourceNetboxVirtualDeviceContextRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimVirtualDeviceContextsReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimVirtualDeviceContextsRead(params, nil)
+
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimVirtualDeviceContextsReadDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	vdc := res.GetPayload()
+
+	d.Set("name", vdc.Name)
+
+	if vdc.Device != nil {
+		d.Set("device_id", vdc.Device.ID)
+	} else {
+		d.Set("device_id", nil)
+	}
+
+	d.Set("status", vdc.Status)
+
+	d.Set("identifier", vdc.Identifier)
+
+	if vdc.Tenant != nil {
+		d.Set("tenant_id", vdc.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	if vdc.PrimaryIp4 != nil {
+		d.Set("primary_ipv4", vdc.PrimaryIp4.ID)
+	} else {
+		d.Set("primary_ipv4", nil)
+	}
+
+	if vdc.PrimaryIp6 != nil {
+		d.Set("primary_ipv6", vdc.PrimaryIp6.ID)
+	} else {
+		d.Set("primary_ipv6", nil)
+	}
+
+	d.Set("description", vdc.Description)
+	d.Set("comments", vdc.Comments)
+	d.Set("interface_count", vdc.InterfaceCount)
+
+	cf := getCustomFields(api, vdc.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, vdc.Tags))
+
+	setObjectMetadata(d, vdc)
+	return nil
+}
+
+func resourceNetboxVirtualDeviceContextUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableVirtualDeviceContext{
+		Name:        strToPtr(d.Get("name").(string)),
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Status:      strToPtr(d.Get("status").(string)),
+		Identifier:  getOptionalInt(d, "identifier"),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		PrimaryIp4:  getOptionalInt(d, "primary_ipv4"),
+		PrimaryIp6:  getOptionalInt(d, "primary_ipv6"),
+		Description: getOptionalStr(d, "description", true),
+		Comments:    getOptionalStr(d, "comments", true),
+	}
+
+	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+
+	ct, ok := d.GetOk(customFieldsKey)
+	if ok {
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
+	}
+
+	params := dcim.NewDcimVirtualDeviceContextsPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err := api.Dcim.DcimVirtualDeviceContextsPartialUpdate(params, nil, withRequestBody(&writableVDC{
+		WritableVirtualDeviceContext: data,
+		Identifier:                   data.Identifier,
+		Tenant:                       data.Tenant,
+		PrimaryIp4:                   data.PrimaryIp4,
+		PrimaryIp6:                   data.PrimaryIp6,
+	}), withClearedStrings(d, "comments", "description"))
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxVirtualDeviceContextRead(d, m)
+}
+
+func resourceNetboxVirtualDeviceContextDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimVirtualDeviceContextsDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimVirtualDeviceContextsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimVirtualDeviceContextsDeleteDefault); ok && errresp.Code() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
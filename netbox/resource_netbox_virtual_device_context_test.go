@@ -0,0 +1,265 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	log "github.com/sirupsen/logrus"
+)
+
+func testAccNetboxVirtualDeviceContextFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+`, testName)
+}
+
+func TestAccNetboxVirtualDeviceContext_basic(t *testing.T) {
+	testSlug := "virtual_device_context_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckVirtualDeviceContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  identifier = 2
+  status = "planned"
+  tenant_id = netbox_tenant.test.id
+  description = "%[1]s_description"
+  comments = "%[1]s_comments"
+  tags = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "identifier", "2"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tags.0", testName+"a"),
+
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tags.#", "0"),
+
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "device_id", "netbox_device.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtual_device_context.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetboxVirtualDeviceContextPrimaryIPDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_raw_object" "interface" {
+  path = "dcim/interfaces"
+  payload = jsonencode({
+    device = tonumber(netbox_device.test.id)
+    name   = "eth0"
+    type   = "1000base-t"
+    vdcs   = [tonumber(netbox_virtual_device_context.test.id)]
+  })
+}
+
+resource "netbox_ip_address" "v4" {
+  ip_address          = "10.22.0.1/24"
+  status              = "active"
+  description         = "%[1]s"
+  device_interface_id = jsondecode(netbox_raw_object.interface.object).id
+}
+
+resource "netbox_ip_address" "v6" {
+  ip_address          = "2001:db8:22::1/64"
+  status              = "active"
+  description         = "%[1]s"
+  device_interface_id = jsondecode(netbox_raw_object.interface.object).id
+}
+`, testName)
+}
+
+func TestAccNetboxVirtualDeviceContext_unset(t *testing.T) {
+	testSlug := "virtual_device_context_unset"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckVirtualDeviceContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + testAccNetboxVirtualDeviceContextPrimaryIPDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  identifier = 2
+  tenant_id = netbox_tenant.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "identifier", "2"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				// The addresses are looked up by their description, because
+				// they depend on the context through their interface.
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + testAccNetboxVirtualDeviceContextPrimaryIPDependencies(testName) + fmt.Sprintf(`
+data "netbox_ip_addresses" "test" {
+  filter {
+    name  = "description"
+    value = "%[1]s"
+  }
+}
+
+locals {
+  addresses = { for ip in data.netbox_ip_addresses.test.ip_addresses : ip.ip_address => ip.id }
+}
+
+resource "netbox_virtual_device_context" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  identifier = 2
+  tenant_id = netbox_tenant.test.id
+  primary_ipv4 = local.addresses["10.22.0.1/24"]
+  primary_ipv6 = local.addresses["2001:db8:22::1/64"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "primary_ipv4", "netbox_ip_address.v4", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "primary_ipv6", "netbox_ip_address.v6", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + testAccNetboxVirtualDeviceContextPrimaryIPDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "identifier", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "primary_ipv4", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "primary_ipv6", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualDeviceContextDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	conn := testAccProvider.Meta().(*providerState)
+
+	// loop through the resources in state, verifying each virtual device
+	// context is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_virtual_device_context" {
+			continue
+		}
+
+		stateID, _ := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		params := dcim.NewDcimVirtualDeviceContextsReadParams().WithID(stateID)
+		_, err := conn.Dcim.DcimVirtualDeviceContextsRead(params, nil)
+
+		if err == nil {
+			return fmt.Errorf("virtual_device_context (%s) still exists", rs.Primary.ID)
+		}
+
+		if err != nil {
+			if errresp, ok := err.(*dcim.DcimVirtualDeviceContextsReadDefault); ok {
+				errorcode := errresp.Code()
+				if errorcode == 404 {
+					return nil
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_virtual_device_context", &resource.Sweeper{
+		Name:         "netbox_virtual_device_context",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			params := dcim.NewDcimVirtualDeviceContextsListParams()
+			res, err := api.Dcim.DcimVirtualDeviceContextsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, vdc := range res.GetPayload().Results {
+				if strings.HasPrefix(*vdc.Name, testPrefix) {
+					deleteParams := dcim.NewDcimVirtualDeviceContextsDeleteParams().WithID(vdc.ID)
+					_, err := api.Dcim.DcimVirtualDeviceContextsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a virtual_device_context")
+				}
+			}
+			return nil
+		},
+	})
+}
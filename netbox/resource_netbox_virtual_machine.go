@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,7 +25,7 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 
 > A virtual machine is a virtualized compute instance. These behave in NetBox very similarly to device objects, but without any physical attributes. For example, a VM may have interfaces assigned to it with IP addresses and VLANs, however its interfaces cannot be connected via cables (because they are virtual). Each VM may also define its compute, memory, and storage resources as well.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,10 +35,12 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"site_id", "cluster_id"},
 			},
+			"cluster": relatedObjectSchema,
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"device_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -48,15 +49,18 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"platform": relatedObjectSchema,
 			"role_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"role": relatedObjectSchema,
 			"site_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				AtLeastOneOf: []string{"site_id", "cluster_id"},
 			},
+			"site": relatedObjectSchema,
 			"comments": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -100,7 +104,7 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
 			customFieldsKey: customFieldsSchema,
-		},
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -116,7 +120,7 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 }
 
 func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
@@ -196,7 +200,7 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 	data.Tags = tags
 	ct, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = ct
+		data.CustomFields = getCustomFieldsForAPI(api, ct)
 	}
 
 	params := virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(&data)
@@ -212,7 +216,7 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
@@ -242,6 +246,7 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("cluster_id", nil)
 	}
+	d.Set("cluster", getRelatedObject(vm.Cluster))
 
 	if vm.PrimaryIp4 != nil {
 		d.Set("primary_ipv4", vm.PrimaryIp4.ID)
@@ -260,6 +265,7 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(vm.Tenant))
 
 	if vm.Device != nil {
 		d.Set("device_id", vm.Device.ID)
@@ -272,12 +278,14 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("role_id", nil)
 	}
+	d.Set("role", getRelatedObject(vm.Role))
 
 	if vm.Platform != nil {
 		d.Set("platform_id", vm.Platform.ID)
 	} else {
 		d.Set("platform_id", nil)
 	}
+	d.Set("platform", getRelatedObject(vm.Platform))
 
 	if vm.Role != nil {
 		d.Set("role_id", vm.Role.ID)
@@ -290,6 +298,7 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("site_id", nil)
 	}
+	d.Set("site", getRelatedObject(vm.Site))
 
 	if vm.LocalContextData != nil {
 		if jsonArr, err := json.Marshal(vm.LocalContextData); err == nil {
@@ -314,18 +323,19 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("status", nil)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(vm.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, vm.Tags))
 
-	cf := getCustomFields(vm.CustomFields)
+	cf := getCustomFields(api, vm.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
+	setObjectMetadata(d, res.GetPayload())
 	return diags
 }
 
 func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableVirtualMachineWithConfigContext{}
@@ -412,7 +422,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 	data.Tags = tags
 	cf, ok := d.GetOk(customFieldsKey)
 	if ok {
-		data.CustomFields = cf
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
 	}
 
 	if d.HasChanges("comments") {
@@ -440,7 +450,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 
 	params := virtualization.NewVirtualizationVirtualMachinesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Virtualization.VirtualizationVirtualMachinesUpdate(params, nil)
+	_, err := api.Virtualization.VirtualizationVirtualMachinesUpdate(params, nil, withClearedStrings(d, "comments", "description"))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -449,7 +459,7 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetboxVirtualMachineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	var diags diag.Diagnostics
 
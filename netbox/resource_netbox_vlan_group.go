@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +22,7 @@ func resourceNetboxVlanGroup() *schema.Resource {
 
 > A VLAN Group represents a collection of VLANs. Generally, these are limited by one of a number of scopes such as "Site" or "Virtualization Cluster".`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,7 +30,7 @@ func resourceNetboxVlanGroup() *schema.Resource {
 			"slug": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 100),
+				ValidateFunc: validateSlug,
 			},
 			"min_vid": {
 				Type:         schema.TypeInt,
@@ -59,8 +58,9 @@ func resourceNetboxVlanGroup() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -68,7 +68,7 @@ func resourceNetboxVlanGroup() *schema.Resource {
 }
 
 func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.VLANGroup{}
 
 	name := d.Get("name").(string)
@@ -93,6 +93,10 @@ func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamVlanGroupsCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamVlanGroupsCreate(params, nil)
 	if err != nil {
@@ -104,7 +108,7 @@ func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVlanGroupsReadParams().WithID(id)
 
@@ -128,7 +132,7 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("min_vid", vlanGroup.MinVid)
 	d.Set("max_vid", vlanGroup.MaxVid)
 	d.Set("description", vlanGroup.Description)
-	d.Set(tagsKey, getTagListFromNestedTagList(vlanGroup.Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, vlanGroup.Tags))
 
 	if vlanGroup.ScopeType != nil {
 		d.Set("scope_type", vlanGroup.ScopeType)
@@ -138,11 +142,17 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("scope_id", vlanGroup.ScopeID)
 	}
 
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.VLANGroup{}
 
@@ -168,8 +178,12 @@ func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error
 
 	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamVlanGroupsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamVlanGroupsUpdate(params, nil)
+	_, err := api.Ipam.IpamVlanGroupsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -177,7 +191,7 @@ func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxVlanGroupDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVlanGroupsDeleteParams().WithID(id)
 	_, err := api.Ipam.IpamVlanGroupsDelete(params, nil)
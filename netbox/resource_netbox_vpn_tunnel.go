@@ -3,12 +3,12 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// The tunnel encapsulations and statuses of Netbox 4.0.
 var resourceNetboxVpnTunnelEncapsulationOptions = []string{"ipsec-transport", "ipsec-tunnel", "ip-ip", "gre"}
 var resourceNetboxVpnTunnelStatusOptions = []string{"planned", "active", "disabled"}
 
@@ -23,20 +23,22 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 
 > NetBox can model private tunnels formed among virtual termination points across your network. Typical tunnel implementations include GRE, IP-in-IP, and IPSec. A tunnel may be terminated to two or more device or virtual machine interfaces. For convenient organization, tunnels may be assigned to user-defined groups.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"encapsulation": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: buildValidValueDescription(resourceNetboxVpnTunnelEncapsulationOptions),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      buildValidValueDescription(resourceNetboxVpnTunnelEncapsulationOptions),
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxVpnTunnelEncapsulationOptions),
 			},
 			"status": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: buildValidValueDescription(resourceNetboxVpnTunnelStatusOptions),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      buildValidValueDescription(resourceNetboxVpnTunnelStatusOptions),
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxVpnTunnelStatusOptions),
 			},
 			"tunnel_group_id": {
 				Type:     schema.TypeInt,
@@ -54,8 +56,9 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -63,7 +66,7 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 }
 
 func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableTunnel{}
 
@@ -79,6 +82,10 @@ func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := vpn.NewVpnTunnelsCreateParams().WithData(&data)
 
 	res, err := api.Vpn.VpnTunnelsCreate(params, nil)
@@ -93,7 +100,7 @@ func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := vpn.NewVpnTunnelsReadParams().WithID(id)
 
@@ -131,12 +138,19 @@ func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("description", tunnel.Description)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableTunnel{}
@@ -153,9 +167,13 @@ func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := vpn.NewVpnTunnelsUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Vpn.VpnTunnelsUpdate(params, nil)
+	_, err := api.Vpn.VpnTunnelsUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -164,7 +182,7 @@ func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNetboxVpnTunnelDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := vpn.NewVpnTunnelsDeleteParams().WithID(id)
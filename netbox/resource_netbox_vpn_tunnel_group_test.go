@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -49,7 +48,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := vpn.NewVpnTunnelGroupsListParams()
 			res, err := api.Vpn.VpnTunnelGroupsList(params, nil)
 			if err != nil {
@@ -3,12 +3,13 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// resourceNetboxVpnTunnelTerminationRoleOptions are the tunnel termination
+// roles of Netbox 4.0.
 var resourceNetboxVpnTunnelTerminationRoleOptions = []string{"peer", "hub", "spoke"}
 
 func resourceNetboxVpnTunnelTermination() *schema.Resource {
@@ -22,15 +23,16 @@ func resourceNetboxVpnTunnelTermination() *schema.Resource {
 
 > NetBox can model private tunnels formed among virtual termination points across your network. Typical tunnel implementations include GRE, IP-in-IP, and IPSec. A tunnel may be terminated to two or more device or virtual machine interfaces. For convenient organization, tunnels may be assigned to user-defined groups.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"tunnel_id": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
 			"role": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: buildValidValueDescription(resourceNetboxVpnTunnelTerminationRoleOptions),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      buildValidValueDescription(resourceNetboxVpnTunnelTerminationRoleOptions),
+				ValidateDiagFunc: validateNetboxChoice(resourceNetboxVpnTunnelTerminationRoleOptions),
 			},
 			"virtual_machine_interface_id": {
 				Type:         schema.TypeInt,
@@ -46,8 +48,9 @@ func resourceNetboxVpnTunnelTermination() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -55,7 +58,7 @@ func resourceNetboxVpnTunnelTermination() *schema.Resource {
 }
 
 func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	data := models.WritableTunnelTermination{}
 
@@ -81,6 +84,10 @@ func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interfac
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := vpn.NewVpnTunnelTerminationsCreateParams().WithData(&data)
 
 	res, err := api.Vpn.VpnTunnelTerminationsCreate(params, nil)
@@ -95,7 +102,7 @@ func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interfac
 }
 
 func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := vpn.NewVpnTunnelTerminationsReadParams().WithID(id)
 
@@ -130,12 +137,19 @@ func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{
 		d.Set("outside_ip_address_id", tunnelTermination.OutsideIP.ID)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, res.GetPayload().Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableTunnelTermination{}
@@ -161,6 +175,10 @@ func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interfac
 	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
 	data.Tags = tags
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := vpn.NewVpnTunnelTerminationsUpdateParams().WithID(id).WithData(&data)
 
 	_, err := api.Vpn.VpnTunnelTerminationsUpdate(params, nil)
@@ -172,7 +190,7 @@ func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interfac
 }
 
 func resourceNetboxVpnTunnelTerminationDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := vpn.NewVpnTunnelTerminationsDeleteParams().WithID(id)
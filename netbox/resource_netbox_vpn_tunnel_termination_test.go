@@ -5,7 +5,6 @@ import (
 	"log"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -159,7 +158,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := vpn.NewVpnTunnelTerminationsListParams()
 			res, err := api.Vpn.VpnTunnelTerminationsList(params, nil)
 			if err != nil {
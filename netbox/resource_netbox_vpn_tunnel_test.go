@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -69,7 +68,7 @@ func init() {
 			if err != nil {
 				return fmt.Errorf("Error getting client: %s", err)
 			}
-			api := m.(*client.NetBoxAPI)
+			api := m.(*providerState)
 			params := vpn.NewVpnTunnelsListParams()
 			res, err := api.Vpn.VpnTunnelsList(params, nil)
 			if err != nil {
@@ -3,7 +3,6 @@ package netbox
 import (
 	"strconv"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +20,7 @@ func resourceNetboxVrf() *schema.Resource {
 
 > A VRF object in NetBox represents a virtual routing and forwarding (VRF) domain. Each VRF is essentially a separate routing table. VRFs are commonly used to isolate customers or organizations from one another within a network, or to route overlapping address space (e.g. multiple instances of the 10.0.0.0/8 space). Each VRF may be assigned to a specific tenant to aid in organizing the available IP space by customer or internal user.`,
 
-		Schema: map[string]*schema.Schema{
+		Schema: withObjectMetadata(map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,6 +33,7 @@ func resourceNetboxVrf() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"tenant": relatedObjectSchema,
 			"enforce_unique": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -45,8 +45,9 @@ func resourceNetboxVrf() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 21),
 			},
 
-			tagsKey: tagsSchema,
-		},
+			tagsKey:         tagsSchema,
+			customFieldsKey: customFieldsSchema,
+		}),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +55,7 @@ func resourceNetboxVrf() *schema.Resource {
 }
 
 func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	data := models.WritableVRF{}
 
 	name := d.Get("name").(string)
@@ -78,6 +79,10 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 	data.ExportTargets = []int64{}
 	data.ImportTargets = []int64{}
 
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamVrfsCreateParams().WithData(&data)
 
 	res, err := api.Ipam.IpamVrfsCreate(params, nil)
@@ -91,7 +96,7 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVrfsReadParams().WithID(id)
 
@@ -122,11 +127,20 @@ func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("tenant", getRelatedObject(vrf.Tenant))
+	d.Set(tagsKey, getResourceTagListFromNestedTagList(api, vrf.Tags))
+
+	cf := getCustomFields(api, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	setObjectMetadata(d, res.GetPayload())
 	return nil
 }
 
 func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableVRF{}
@@ -150,9 +164,13 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 	if tenantID, ok := d.GetOk("tenant_id"); ok {
 		data.Tenant = int64ToPtr(int64(tenantID.(int)))
 	}
+	if cf, ok := d.GetOk(customFieldsKey); ok {
+		data.CustomFields = getCustomFieldsForAPI(api, cf)
+	}
+
 	params := ipam.NewIpamVrfsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Ipam.IpamVrfsPartialUpdate(params, nil)
+	_, err := api.Ipam.IpamVrfsPartialUpdate(params, nil, withClearedStrings(d, "description"))
 	if err != nil {
 		return err
 	}
@@ -161,7 +179,7 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceNetboxVrfDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	params := ipam.NewIpamVrfsDeleteParams().WithID(id)
@@ -0,0 +1,39 @@
+package netbox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var allowMissingSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     false,
+	Description: "Leave all attributes empty instead of failing if no object matches.",
+}
+
+var failOnMultipleSchema = &schema.Schema{
+	Type:        schema.TypeBool,
+	Optional:    true,
+	Default:     true,
+	Description: "Fail if more than one object matches. Otherwise, the first matching object in the order of Netbox is used.",
+}
+
+// checkSingleResult applies the `allow_missing` and `fail_on_multiple`
+// attributes of a singular data source to the number of objects matching
+// its filters. It returns false if the data source must not read the first
+// object, together with the error to return, if any.
+func checkSingleResult(d *schema.ResourceData, count int64, label string) (bool, error) {
+	switch {
+	case count > 1 && d.Get("fail_on_multiple").(bool):
+		return false, fmt.Errorf("more than one %s returned, specify a more narrow filter", label)
+	case count == 0 && d.Get("allow_missing").(bool):
+		d.SetId(id.UniqueId())
+		return false, nil
+	case count == 0:
+		return false, fmt.Errorf("no %s found matching filter", label)
+	}
+	return true, nil
+}
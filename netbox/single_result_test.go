@@ -0,0 +1,36 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSingleResult(t *testing.T) {
+	singleSchema := map[string]*schema.Schema{
+		"allow_missing":    allowMissingSchema,
+		"fail_on_multiple": failOnMultipleSchema,
+	}
+
+	d := schema.TestResourceDataRaw(t, singleSchema, map[string]interface{}{})
+	found, err := checkSingleResult(d, 1, "site")
+	assert.True(t, found)
+	assert.NoError(t, err)
+
+	_, err = checkSingleResult(d, 2, "site")
+	assert.EqualError(t, err, "more than one site returned, specify a more narrow filter")
+
+	_, err = checkSingleResult(d, 0, "site")
+	assert.EqualError(t, err, "no site found matching filter")
+
+	d = schema.TestResourceDataRaw(t, singleSchema, map[string]interface{}{"allow_missing": true, "fail_on_multiple": false})
+	found, err = checkSingleResult(d, 2, "site")
+	assert.True(t, found)
+	assert.NoError(t, err)
+
+	found, err = checkSingleResult(d, 0, "site")
+	assert.False(t, found)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, d.Id())
+}
@@ -1,7 +1,14 @@
 package netbox
 
 import (
+	"context"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSlugGeneration(t *testing.T) {
@@ -33,6 +40,31 @@ func TestSlugGeneration(t *testing.T) {
 			input:    "Foo & 33 bar -- yes-",
 			expected: "foo-33-bar-yes",
 		},
+		{
+			name:     "Dots",
+			input:    "Rack 1.2 ..",
+			expected: "rack-1-2",
+		},
+		{
+			name:     "Punctuation",
+			input:    "Site #1 (Main), Building: A",
+			expected: "site-1-main-building-a",
+		},
+		{
+			name:     "Unicode",
+			input:    "Zürich Straße\u00a0Ost",
+			expected: "zrich-strae-ost",
+		},
+		{
+			name:     "Long",
+			input:    strings.Repeat("a", 150),
+			expected: strings.Repeat("a", 100),
+		},
+		{
+			name:     "LongTruncatedAtSeparator",
+			input:    strings.Repeat("a", 99) + " b",
+			expected: strings.Repeat("a", 99),
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			actual := getSlug(tt.input)
@@ -42,3 +74,53 @@ func TestSlugGeneration(t *testing.T) {
 		})
 	}
 }
+
+func TestWrapSlugFromName(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+	wrapSlugFromName(r, "name")
+	assert.Contains(t, r.Schema["slug"].Description, "fixed at creation: changing `name` later")
+
+	for _, tc := range []struct {
+		name     string
+		state    map[string]string
+		config   map[string]interface{}
+		expected string
+	}{
+		{"Create", nil, map[string]interface{}{"name": "My Site"}, "my-site"},
+		{"CreateWithSlug", nil, map[string]interface{}{"name": "My Site", "slug": "custom"}, "custom"},
+		{"Rename", map[string]string{"name": "Old", "slug": "old"}, map[string]interface{}{"name": "New"}, ""},
+		{"Unchanged", map[string]string{"name": "Site", "slug": "custom"}, map[string]interface{}{"name": "Site"}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rawConfig := map[string]cty.Value{"name": cty.StringVal(tc.config["name"].(string)), "slug": cty.NullVal(cty.String)}
+			if slug, ok := tc.config["slug"]; ok {
+				rawConfig["slug"] = cty.StringVal(slug.(string))
+			}
+			state := &terraform.InstanceState{RawConfig: cty.ObjectVal(rawConfig)}
+			if tc.state != nil {
+				state.ID = "1"
+				state.Attributes = tc.state
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			assert.NoError(t, err)
+			if tc.expected == "" {
+				assert.True(t, diff == nil || diff.Attributes["slug"] == nil)
+			} else {
+				assert.Equal(t, tc.expected, diff.Attributes["slug"].New)
+			}
+		})
+	}
+}
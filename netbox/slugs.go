@@ -1,20 +1,74 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// slugMaxLength is the maximum length of slugs in Netbox.
+const slugMaxLength = 100
+
+// slugSourceKeys are the attributes slugs are generated from for the
+// resources that do not generate them from name.
+var slugSourceKeys = map[string]string{
+	"netbox_device_type": "model",
+}
+
+// slugInvalidCharacters and slugSeparators implement the slugify function
+// of the Netbox UI. Like there, \w only matches ASCII word characters, so
+// other letters are removed instead of being transliterated, while all
+// Unicode spaces separate words.
+var (
+	slugInvalidCharacters = regexp.MustCompile(`[^-.\w\s\p{Z}]`)
+	slugSeparators        = regexp.MustCompile(`[-.\s\p{Z}]+`)
 )
 
+// getSlug generates a slug from name following the rules of Netbox: special
+// characters are removed, blocks of whitespace, dots and dashes become a
+// single dash and the result is lowercased. Leading and trailing dashes are
+// trimmed and the slug is truncated to slugMaxLength.
 func getSlug(name string) string {
-	var result string
-	// \w = word characters (== [0-9A-Za-z_])
-	// \s = whitespace (== [\t\n\f\r ])
-	matchSpecial, _ := regexp.Compile(`[^\w\s-]`)
-	matchMultiWhitespacesAndDashes, _ := regexp.Compile(`[\s-]+`)
-	// Special chars are stripped
-	result = matchSpecial.ReplaceAllString(name, "")
-	// Blocks of multiple whitespaces and dashes will be replaced by a single dash
-	result = matchMultiWhitespacesAndDashes.ReplaceAllString(result, "-")
-	result = strings.Trim(result, "-")
-	return strings.ToLower(result)
+	result := slugInvalidCharacters.ReplaceAllString(name, "")
+	result = slugSeparators.ReplaceAllString(result, "-")
+	result = strings.ToLower(strings.Trim(result, "-"))
+	if len(result) > slugMaxLength {
+		result = strings.TrimRight(result[:slugMaxLength], "-")
+	}
+	return result
+}
+
+// wrapSlugFromName makes the plan of the given resource contain the slug
+// generated from the source attribute whenever slug is not configured, so
+// that the slug is known at plan time. The slug is only generated on create:
+// renaming an object keeps its slug, since other configurations and URLs may
+// refer to it. The description of the slug attribute documents this.
+func wrapSlugFromName(r *schema.Resource, sourceKey string) {
+	if s := r.Schema["slug"]; s.Description == "" {
+		s.Description = fmt.Sprintf("Defaults to the slug generated from `%[1]s` when the object is created, like the `slugify` function does. The slug is fixed at creation: changing `%[1]s` later does not change it, set `slug` to change it.", sourceKey)
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() || !config.GetAttr("slug").IsNull() {
+			return nil
+		}
+		if d.Id() != "" {
+			return nil
+		}
+		if !d.NewValueKnown(sourceKey) {
+			return d.SetNewComputed("slug")
+		}
+		return d.SetNew("slug", getSlug(d.Get(sourceKey).(string)))
+	}
 }
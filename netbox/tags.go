@@ -3,11 +3,11 @@ package netbox
 import (
 	"fmt"
 
-	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
 )
 
 const tagsKey = "tags"
@@ -30,10 +30,14 @@ var tagsSchemaRead = &schema.Schema{
 	Set:      schema.HashString,
 }
 
-func getNestedTagListFromResourceDataSet(client *client.NetBoxAPI, d interface{}) ([]*models.NestedTag, diag.Diagnostics) {
+func getNestedTagListFromResourceDataSet(client *providerState, d interface{}) ([]*models.NestedTag, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	tagList := d.(*schema.Set).List()
+	tagSet := schema.NewSet(schema.HashString, d.(*schema.Set).List())
+	for _, tag := range client.defaultTags {
+		tagSet.Add(tag)
+	}
+	tagList := tagSet.List()
 	tags := []*models.NestedTag{}
 	for _, tag := range tagList {
 		tagString := tag.(string)
@@ -83,3 +87,16 @@ func getTagListFromNestedTagList(nestedTags []*models.NestedTag) []string {
 	}
 	return tags
 }
+
+// getResourceTagListFromNestedTagList returns the tags of a managed object,
+// leaving out the provider level default tags.
+func getResourceTagListFromNestedTagList(client *providerState, nestedTags []*models.NestedTag) []string {
+	defaults := client.defaultTags
+	tags := []string{}
+	for _, tag := range getTagListFromNestedTagList(nestedTags) {
+		if !slices.Contains(defaults, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
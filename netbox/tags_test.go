@@ -3,6 +3,7 @@ package netbox
 import (
 	"testing"
 
+	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,3 +27,24 @@ func TestGetTagListFromNestedTagList(t *testing.T) {
 	}
 	assert.Equal(t, flat, expected)
 }
+
+func TestGetResourceTagListFromNestedTagListWithoutDefaults(t *testing.T) {
+	api := &providerState{NetBoxAPI: &client.NetBoxAPI{}, defaultTags: []string{"Bar"}}
+
+	tags := []*models.NestedTag{
+		{
+			Name: strToPtr("Foo"),
+			Slug: strToPtr("foo"),
+		},
+		{
+			Name: strToPtr("Bar"),
+			Slug: strToPtr("bar"),
+		},
+	}
+
+	flat := getResourceTagListFromNestedTagList(api, tags)
+	expected := []string{
+		"Foo",
+	}
+	assert.Equal(t, flat, expected)
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -113,6 +114,17 @@ func getOptionalFloat(d *schema.ResourceData, key string) *float64 {
 	return getOptionalVal[float64, float64](d, key)
 }
 
+// getWriteOnlyStr returns the configured value of a write-only string
+// attribute. Write-only values are never persisted to the plan or state, so
+// they can only be read from the raw configuration during apply.
+func getWriteOnlyStr(d *schema.ResourceData, key string) string {
+	val, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() || !val.Type().Equals(cty.String) || val.IsNull() || !val.IsKnown() {
+		return ""
+	}
+	return val.AsString()
+}
+
 // jsonSemanticCompare returns true when 2 json strings encode the same
 // structure, regardless of whitespace differences. This can be used in
 // DiffSuppressFunc implementations to prevent terraform showing whitespace
@@ -1,6 +1,16 @@
 package netbox
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
 
 const (
 	maxUint16 = ^uint16(0)
@@ -13,4 +23,33 @@ const (
 var (
 	validatePositiveInt16 = validation.IntBetween(0, maxInt16)
 	validatePositiveInt32 = validation.IntBetween(0, maxInt32)
+
+	// validateSlug accepts the slugs Netbox accepts: up to 100 letters,
+	// numbers, underscores and hyphens.
+	validateSlug = validation.All(
+		validation.StringLenBetween(1, slugMaxLength),
+		validation.StringMatch(regexp.MustCompile(`^[-a-zA-Z0-9_]+$`), "must only contain letters, numbers, underscores and hyphens"),
+	)
+	// validateColorHex accepts colors in the format Netbox expects, e.g.
+	// `9e9e9e`.
+	validateColorHex = validation.StringMatch(regexp.MustCompile(`^[0-9a-f]{6}$`), "must be a 6-digit lowercase hex color without leading #")
 )
+
+// validateNetboxChoice warns about values that are not among the given
+// choices, which are taken from Netbox 4.0. Newer Netbox versions add
+// choices, e.g. port types, so values unknown to the provider are still sent
+// to Netbox, which rejects them if they are invalid.
+func validateNetboxChoice(choices []string) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		value, ok := v.(string)
+		if !ok || slices.Contains(choices, value) {
+			return nil
+		}
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Unknown value %q", value),
+			Detail:        fmt.Sprintf("%q is not one of the values of Netbox 4.0: %s. It is sent to Netbox anyway, since newer versions of Netbox may accept it.", value, strings.Join(choices, ", ")),
+			AttributePath: path,
+		}}
+	}
+}
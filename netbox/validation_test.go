@@ -0,0 +1,56 @@
+package netbox
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSlug(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		valid bool
+	}{
+		{"my-site_1", true},
+		{"My-Site", true},
+		{"", false},
+		{"my site", false},
+		{"my.site", false},
+		{strings.Repeat("a", 100), true},
+		{strings.Repeat("a", 101), false},
+	} {
+		_, errs := validateSlug(tc.value, "slug")
+		assert.Equal(t, tc.valid, len(errs) == 0, tc.value)
+	}
+}
+
+func TestValidateColorHex(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		valid bool
+	}{
+		{"9e9e9e", true},
+		{"9E9E9E", false},
+		{"#9e9e9e", false},
+		{"9e9", false},
+		{"red", false},
+	} {
+		_, errs := validateColorHex(tc.value, "color_hex")
+		assert.Equal(t, tc.valid, len(errs) == 0, tc.value)
+	}
+}
+
+func TestValidateNetboxChoice(t *testing.T) {
+	validate := validateNetboxChoice([]string{"8p8c", "lc"})
+
+	assert.Empty(t, validate("lc", cty.GetAttrPath("type")))
+
+	diags := validate("lc-pc-2", cty.GetAttrPath("type"))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, cty.GetAttrPath("type"), diags[0].AttributePath)
+	}
+}